@@ -23,6 +23,7 @@ func newCanvas(canvasNative *C.cairo_t) (*Canvas, error) {
 	}
 
 	c := &Canvas{canvasNative}
+	debugTrack("Canvas", unsafe.Pointer(c))
 
 	runtime.SetFinalizer(c, (*Canvas).destroy)
 
@@ -31,21 +32,39 @@ func newCanvas(canvasNative *C.cairo_t) (*Canvas, error) {
 
 func (c *Canvas) destroy() {
 	C.cairo_destroy(c.cr)
+	debugUntrack(unsafe.Pointer(c), false)
 }
 
 func (c *Canvas) Destroy() {
 
 	if c.cr == nil {
+		c.debugCheck()
 		return
 	}
+	debugUntrack(unsafe.Pointer(c), true)
 	c.destroy()
 	c.cr = nil
 
 	runtime.SetFinalizer(c, nil)
 }
 
+func (c *Canvas) debugCheck() {
+	if debugEnabled && c.cr == nil {
+		debugPanicDestroyed("Canvas", unsafe.Pointer(c))
+	}
+}
+
+func (c *Canvas) isDestroyed() bool {
+	c.debugCheck()
+	return c.cr == nil
+}
+
 func NewCanvas(s *Surface) (*Canvas, error) {
 
+	if s.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
 	canvas_n := C.cairo_create(s.surfaceNative)
 
 	return newCanvas(canvas_n)
@@ -68,40 +87,74 @@ func (c *Canvas) Native() uintptr {
 
 func (c *Canvas) Reference() *Canvas {
 
+	if c.isDestroyed() {
+		return nil
+	}
+
 	reference := C.cairo_reference(c.cr)
 
-	cr, _ := newCanvas(reference)
+	cr, err := newCanvas(reference)
+	if err != nil {
+		C.cairo_destroy(reference)
+		return nil
+	}
 
 	return cr
 }
 
 func (c *Canvas) Status() Status {
+	if c.isDestroyed() {
+		return STATUS_NULL_POINTER
+	}
 	return Status(C.cairo_status(c.cr))
 }
 
 func (c *Canvas) Save() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_save(c.cr)
 }
 
 func (c *Canvas) Restore() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_restore(c.cr)
 }
 
 func (c *Canvas) GetTarget() *Surface {
 
-	var surfaceNative *C.cairo_surface_t
-	surfaceNative = C.cairo_get_target(c.cr)
+	if c.isDestroyed() {
+		return nil
+	}
+
+	surfaceNative := C.cairo_get_target(c.cr)
 	if surfaceNative == nil {
 		return nil
 	}
-	return &Surface{surfaceNative}
+	reference := C.cairo_surface_reference(surfaceNative)
+
+	s, err := newSurface(reference)
+	if err != nil {
+		C.cairo_surface_destroy(reference)
+		return nil
+	}
+
+	return s
 }
 
 func (c *Canvas) PushGroup() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_push_group(c.cr)
 }
 
 func (c *Canvas) PushGroupWithContent(content Content) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_push_group_with_content(c.cr, C.cairo_content_t(content))
 }
 
@@ -110,45 +163,78 @@ func (c *Canvas) PushGroupWithContent(content Content) {
 // cairo_pop_group_to_source ()
 
 func (c *Canvas) GetGroupTarget() *Surface {
-	var surfaceNative *C.cairo_surface_t
-	surfaceNative = C.cairo_get_group_target(c.cr)
+
+	if c.isDestroyed() {
+		return nil
+	}
+
+	surfaceNative := C.cairo_get_group_target(c.cr)
 	if surfaceNative == nil {
 		return nil
 	}
-	return &Surface{surfaceNative}
+	reference := C.cairo_surface_reference(surfaceNative)
+
+	s, err := newSurface(reference)
+	if err != nil {
+		C.cairo_surface_destroy(reference)
+		return nil
+	}
+
+	return s
 }
 
 func (c *Canvas) SetSource(p *Pattern) {
+	if c.isDestroyed() || p.isDestroyed() {
+		return
+	}
 	C.cairo_set_source(c.cr, p.pattern_n)
 }
 
 func (c *Canvas) SetSourceSurface(s *Surface, x, y float64) {
+	if c.isDestroyed() || s.isDestroyed() {
+		return
+	}
 	C.cairo_set_source_surface(c.cr, s.surfaceNative, C.double(x), C.double(y))
 }
 
 func (c *Canvas) GetSource() *Pattern {
 
-	var (
-		patternNative    *C.cairo_pattern_t
-		patternReference *C.cairo_pattern_t
-	)
+	if c.isDestroyed() {
+		return nil
+	}
 
-	patternNative = C.cairo_get_source(c.cr)
-	patternReference = C.cairo_pattern_reference(patternNative)
+	patternNative := C.cairo_get_source(c.cr)
+	reference := C.cairo_pattern_reference(patternNative)
 
-	return &Pattern{patternReference}
+	p, err := newPattern(reference)
+	if err != nil {
+		C.cairo_pattern_destroy(reference)
+		return nil
+	}
+
+	return p
 }
 
 func (c *Canvas) SetAntialias(antialias Antialias) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_antialias(c.cr, C.cairo_antialias_t(antialias))
 }
 
 func (c *Canvas) GetAntialias() Antialias {
+	if c.isDestroyed() {
+		return 0
+	}
 	return Antialias(C.cairo_get_antialias(c.cr))
 }
 
 func (c *Canvas) SetDash(dashes []float64, offset float64) {
 
+	if c.isDestroyed() {
+		return
+	}
+
 	if len(dashes) == 0 {
 		C.cairo_set_dash(c.cr, nil, 0, 0.0)
 		return
@@ -164,84 +250,141 @@ func (c *Canvas) SetDash(dashes []float64, offset float64) {
 }
 
 func (c *Canvas) GetDashCount() int {
+	if c.isDestroyed() {
+		return 0
+	}
 	return int(C.cairo_get_dash_count(c.cr))
 }
 
 // cairo_get_dash ()
 
 func (c *Canvas) SetFillRule(fillRule FillRule) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_fill_rule(c.cr, C.cairo_fill_rule_t(fillRule))
 }
 
 func (c *Canvas) GetFillRule() FillRule {
+	if c.isDestroyed() {
+		return 0
+	}
 	return FillRule(C.cairo_get_fill_rule(c.cr))
 }
 
 func (c *Canvas) SetLineCap(lineCap LineCap) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_line_cap(c.cr, C.cairo_line_cap_t(lineCap))
 }
 
 func (c *Canvas) GetLineCap() LineCap {
+	if c.isDestroyed() {
+		return 0
+	}
 	return LineCap(C.cairo_get_line_cap(c.cr))
 }
 
 func (c *Canvas) SetLineJoin(lineJoin LineJoin) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_line_join(c.cr, C.cairo_line_join_t(lineJoin))
 }
 
 func (c *Canvas) GetLineJoin() LineJoin {
+	if c.isDestroyed() {
+		return 0
+	}
 	return LineJoin(C.cairo_get_line_join(c.cr))
 }
 
 func (c *Canvas) SetLineWidth(width float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_line_width(c.cr, C.double(width))
 }
 
 func (c *Canvas) GetLineWidth() float64 {
+	if c.isDestroyed() {
+		return 0
+	}
 	return float64(C.cairo_get_line_width(c.cr))
 }
 
 func (c *Canvas) SetMiterLimit(limit float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_miter_limit(c.cr, C.double(limit))
 }
 
 func (c *Canvas) GetMiterLimit() float64 {
+	if c.isDestroyed() {
+		return 0
+	}
 	return float64(C.cairo_get_miter_limit(c.cr))
 }
 
 func (c *Canvas) SetOperator(operator Operator) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_operator(c.cr, C.cairo_operator_t(operator))
 }
 
 func (c *Canvas) GetOperator() Operator {
+	if c.isDestroyed() {
+		return 0
+	}
 	return Operator(C.cairo_get_operator(c.cr))
 }
 
 func (c *Canvas) SetTolerance(tolerance float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_tolerance(c.cr, C.double(tolerance))
 }
 
 func (c *Canvas) GetTolerance() float64 {
+	if c.isDestroyed() {
+		return 0
+	}
 	return float64(C.cairo_get_tolerance(c.cr))
 }
 
 func (c *Canvas) Clip() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_clip(c.cr)
 }
 
 func (c *Canvas) ClipPreserve() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_clip_preserve(c.cr)
 }
 
 // cairo_clip_extents ()
 
 func (c *Canvas) InClip(x, y float64) bool {
+	if c.isDestroyed() {
+		return false
+	}
 	var b C.cairo_bool_t
 	b = C.cairo_in_clip(c.cr, C.double(x), C.double(y))
 	return boolGolang(b)
 }
 
 func (c *Canvas) ResetClip() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_reset_clip(c.cr)
 }
 
@@ -250,16 +393,25 @@ func (c *Canvas) ResetClip() {
 // cairo_copy_clip_rectangle_list ()
 
 func (c *Canvas) Fill() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_fill(c.cr)
 }
 
 func (c *Canvas) FillPreserve() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_fill_preserve(c.cr)
 }
 
 // cairo_fill_extents ()
 
 func (c *Canvas) InFill(x, y float64) bool {
+	if c.isDestroyed() {
+		return false
+	}
 	var b C.cairo_bool_t
 	b = C.cairo_in_fill(c.cr, C.double(x), C.double(y))
 	return boolGolang(b)
@@ -270,85 +422,135 @@ func (c *Canvas) InFill(x, y float64) bool {
 // cairo_mask_surface ()
 
 func (c *Canvas) Paint() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_paint(c.cr)
 }
 
 func (c *Canvas) PaintWithAlpha(alpha float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_paint_with_alpha(c.cr, C.double(alpha))
 }
 
 func (c *Canvas) Stroke() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_stroke(c.cr)
 }
 
 func (c *Canvas) StrokePreserve() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_stroke_preserve(c.cr)
 }
 
 // cairo_stroke_extents ()
 
 func (c *Canvas) InStroke(x, y float64) bool {
+	if c.isDestroyed() {
+		return false
+	}
 	var b C.cairo_bool_t
 	b = C.cairo_in_stroke(c.cr, C.double(x), C.double(y))
 	return boolGolang(b)
 }
 
 func (c *Canvas) CopyPage() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_copy_page(c.cr)
 }
 
 func (c *Canvas) ShowPage() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_show_page(c.cr)
 }
 
 func (c *Canvas) GetReferenceCount() uint {
+	if c.isDestroyed() {
+		return 0
+	}
 	return uint(C.cairo_get_reference_count(c.cr))
 }
 
-// cairo_set_user_data ()
-
-// cairo_get_user_data ()
-
 // ------------------------------------------
 func (c *Canvas) MoveTo(x, y float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_move_to(c.cr, C.double(x), C.double(y))
 }
 
 func (c *Canvas) LineTo(x, y float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_line_to(c.cr, C.double(x), C.double(y))
 }
 
 func (c *Canvas) RelLineTo(dx, dy float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_rel_line_to(c.cr, C.double(dx), C.double(dy))
 }
 
 func (c *Canvas) Rectangle(x, y, width, height float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_rectangle(c.cr,
 		C.double(x), C.double(y),
 		C.double(width), C.double(height))
 }
 
 func (c *Canvas) NewPath() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_new_path(c.cr)
 }
 
 func (c *Canvas) NewSubPath() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_new_sub_path(c.cr)
 }
 
 func (c *Canvas) ClosePath() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_close_path(c.cr)
 }
 
 func (c *Canvas) Arc(xc, yc, radius, angle1, angle2 float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_arc(c.cr, C.double(xc), C.double(yc), C.double(radius), C.double(angle1), C.double(angle2))
 }
 
 func (c *Canvas) ArcNegative(xc, yc, radius, angle1, angle2 float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_arc_negative(c.cr, C.double(xc), C.double(yc), C.double(radius), C.double(angle1), C.double(angle2))
 }
 
 func (c *Canvas) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_curve_to(c.cr,
 		C.double(x1), C.double(y1),
 		C.double(x2), C.double(y2),
@@ -358,30 +560,51 @@ func (c *Canvas) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
 // Transformations
 
 func (c *Canvas) Scale(sx, sy float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_scale(c.cr, C.double(sx), C.double(sy))
 }
 
 func (c *Canvas) Translate(tx, ty float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_translate(c.cr, C.double(tx), C.double(ty))
 }
 
 func (c *Canvas) Rotate(angle float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_rotate(c.cr, C.double(angle))
 }
 
 func (c *Canvas) Transform(matrix *Matrix) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_transform(c.cr, matrix.matrixNative)
 }
 
 func (c *Canvas) SetMatrix(matrix *Matrix) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_matrix(c.cr, matrix.matrixNative)
 }
 
 func (c *Canvas) GetMatrix(matrix *Matrix) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_get_matrix(c.cr, matrix.matrixNative)
 }
 
 func (c *Canvas) IdentityMatrix() {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_identity_matrix(c.cr)
 }
 
@@ -389,6 +612,10 @@ func (c *Canvas) IdentityMatrix() {
 
 func (c *Canvas) SelectFontFace(family string, fontSlant FontSlant, fontWeight FontWeight) {
 
+	if c.isDestroyed() {
+		return
+	}
+
 	cstrFamily := newCString(family)
 	defer freeCString(cstrFamily)
 
@@ -397,14 +624,100 @@ func (c *Canvas) SelectFontFace(family string, fontSlant FontSlant, fontWeight F
 		C.cairo_font_weight_t(fontWeight))
 }
 
+func (c *Canvas) SetFontFace(f *FontFace) {
+	if c.isDestroyed() || f.isDestroyed() {
+		return
+	}
+	C.cairo_set_font_face(c.cr, f.fontFaceNative)
+}
+
+func (c *Canvas) GetFontFace() *FontFace {
+
+	if c.isDestroyed() {
+		return nil
+	}
+
+	fontFaceNative := C.cairo_get_font_face(c.cr)
+	reference := C.cairo_font_face_reference(fontFaceNative)
+
+	f, err := newFontFace(reference)
+	if err != nil {
+		C.cairo_font_face_destroy(reference)
+		return nil
+	}
+
+	return f
+}
+
+func (c *Canvas) SetScaledFont(sf *ScaledFont) {
+	if c.isDestroyed() || sf.isDestroyed() {
+		return
+	}
+	C.cairo_set_scaled_font(c.cr, sf.scaledFontNative)
+}
+
+func (c *Canvas) GetScaledFont() *ScaledFont {
+
+	if c.isDestroyed() {
+		return nil
+	}
+
+	scaledFontNative := C.cairo_get_scaled_font(c.cr)
+	reference := C.cairo_scaled_font_reference(scaledFontNative)
+
+	sf, err := newScaledFont(reference)
+	if err != nil {
+		C.cairo_scaled_font_destroy(reference)
+		return nil
+	}
+
+	return sf
+}
+
+func (c *Canvas) SetFontOptions(options *FontOptions) {
+	if c.isDestroyed() || options.isDestroyed() {
+		return
+	}
+	C.cairo_set_font_options(c.cr, options.fontOptionsNative)
+}
+
+// GetFontOptions copies the font options set by SetFontOptions to options.
+func (c *Canvas) GetFontOptions(options *FontOptions) {
+	if c.isDestroyed() || options.isDestroyed() {
+		return
+	}
+	C.cairo_get_font_options(c.cr, options.fontOptionsNative)
+}
+
 func (c *Canvas) SetFontSize(size float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_font_size(c.cr, C.double(size))
 }
 
+func (c *Canvas) SetFontMatrix(matrix *Matrix) {
+	if c.isDestroyed() {
+		return
+	}
+	C.cairo_set_font_matrix(c.cr, matrix.matrixNative)
+}
+
+func (c *Canvas) GetFontMatrix(matrix *Matrix) {
+	if c.isDestroyed() {
+		return
+	}
+	C.cairo_get_font_matrix(c.cr, matrix.matrixNative)
+}
+
 // Text
 
 func (c *Canvas) ShowText(text string) {
 
+	if c.isDestroyed() {
+		return
+	}
+
 	cstr := newCString(text)
 	defer freeCString(cstr)
 
@@ -413,6 +726,10 @@ func (c *Canvas) ShowText(text string) {
 
 func (c *Canvas) TextPath(text string) {
 
+	if c.isDestroyed() {
+		return
+	}
+
 	cstr := newCString(text)
 	defer freeCString(cstr)
 
@@ -430,6 +747,10 @@ type TextExtents struct {
 
 func (c *Canvas) TextExtents(text string, textExtents *TextExtents) {
 
+	if c.isDestroyed() {
+		return
+	}
+
 	if textExtents == nil {
 		return
 	}
@@ -441,10 +762,47 @@ func (c *Canvas) TextExtents(text string, textExtents *TextExtents) {
 
 	C.cairo_text_extents(c.cr, cstr, &extents)
 
-	textExtents.BearingX = float64(extents.x_bearing)
-	textExtents.BearingY = float64(extents.y_bearing)
-	textExtents.Width = float64(extents.width)
-	textExtents.Height = float64(extents.height)
-	textExtents.AdvanceX = float64(extents.x_advance)
-	textExtents.AdvanceY = float64(extents.y_advance)
+	textExtents.setNative(&extents)
+}
+
+func (e *TextExtents) setNative(extents *C.cairo_text_extents_t) {
+	e.BearingX = float64(extents.x_bearing)
+	e.BearingY = float64(extents.y_bearing)
+	e.Width = float64(extents.width)
+	e.Height = float64(extents.height)
+	e.AdvanceX = float64(extents.x_advance)
+	e.AdvanceY = float64(extents.y_advance)
+}
+
+type FontExtents struct {
+	Ascent      float64
+	Descent     float64
+	Height      float64
+	MaxAdvanceX float64
+	MaxAdvanceY float64
+}
+
+func (c *Canvas) FontExtents(fontExtents *FontExtents) {
+
+	if c.isDestroyed() {
+		return
+	}
+
+	if fontExtents == nil {
+		return
+	}
+
+	var extents C.cairo_font_extents_t
+
+	C.cairo_font_extents(c.cr, &extents)
+
+	fontExtents.setNative(&extents)
+}
+
+func (e *FontExtents) setNative(extents *C.cairo_font_extents_t) {
+	e.Ascent = float64(extents.ascent)
+	e.Descent = float64(extents.descent)
+	e.Height = float64(extents.height)
+	e.MaxAdvanceX = float64(extents.max_x_advance)
+	e.MaxAdvanceY = float64(extents.max_y_advance)
 }
@@ -15,11 +15,17 @@ import (
 const maxColorComponent = 0xffff
 
 func (c *Canvas) SetSourceRGB(red, green, blue float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_source_rgb(c.cr,
 		C.double(red), C.double(green), C.double(blue))
 }
 
 func (c *Canvas) SetSourceRGBA(red, green, blue, alpha float64) {
+	if c.isDestroyed() {
+		return
+	}
 	C.cairo_set_source_rgba(c.cr,
 		C.double(red), C.double(green), C.double(blue), C.double(alpha))
 }
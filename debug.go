@@ -0,0 +1,198 @@
+package cairo
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Debug mode is enabled by building with the cairo_debug tag:
+//
+//	go run -tags cairo_debug .
+//
+// In debug mode the wrappers of cairo objects record the stack trace where
+// they were created, LiveObjects reports the objects which are not destroyed
+// yet, and using an object after Destroy (or destroying it twice) panics with
+// the stack traces where it was created and destroyed. The stack traces are
+// kept for the last debugDestroyedLimit destroyed objects.
+// Without the tag the tracking code is compiled out, and the methods of
+// a destroyed object return zero values or ErrDestroyed.
+
+// LiveObject is a cairo object which was created and not destroyed yet.
+type LiveObject struct {
+	Type  string // Canvas, Surface, Pattern, ...
+	Stack string // stack trace of the creation
+	id    uint64
+}
+
+type debugDestroyed struct {
+	object LiveObject
+	stack  string // stack trace of Destroy
+}
+
+type debugDestroyedKey struct {
+	addr uintptr
+	id   uint64
+}
+
+// debugDestroyedLimit is the number of the last destroyed objects which
+// stack traces are kept to report their use.
+const debugDestroyedLimit = 4096
+
+// The objects are keyed by the address of the wrapper. The addresses are
+// kept as integers, so the tracking doesn't keep the wrappers alive.
+var debugObjects = struct {
+	sync.Mutex
+	live           map[uintptr]LiveObject
+	destroyed      map[uintptr]debugDestroyed
+	destroyedOrder []debugDestroyedKey // oldest first
+	last           uint64
+}{
+	live:      make(map[uintptr]LiveObject),
+	destroyed: make(map[uintptr]debugDestroyed),
+}
+
+func debugStack(skip int) string {
+
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(skip+1, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+// debugTrack registers a new wrapper.
+func debugTrack(typeName string, p unsafe.Pointer) {
+	if !debugEnabled {
+		return
+	}
+
+	stack := debugStack(3)
+
+	debugObjects.Lock()
+	defer debugObjects.Unlock()
+
+	debugObjects.last++
+	addr := uintptr(p)
+	debugObjects.live[addr] = LiveObject{
+		Type:  typeName,
+		Stack: stack,
+		id:    debugObjects.last,
+	}
+	delete(debugObjects.destroyed, addr)
+}
+
+// debugUntrack removes a wrapper which native object is released.
+// explicit is true for Destroy and false for the finalizer.
+func debugUntrack(p unsafe.Pointer, explicit bool) {
+	if !debugEnabled {
+		return
+	}
+
+	var stack string
+	if explicit {
+		stack = debugStack(3)
+	}
+
+	debugObjects.Lock()
+	defer debugObjects.Unlock()
+
+	addr := uintptr(p)
+	object, ok := debugObjects.live[addr]
+	if !ok {
+		return
+	}
+	delete(debugObjects.live, addr)
+
+	// The record is needed only to report the use of a destroyed wrapper,
+	// which is not possible after the finalizer.
+	if explicit {
+		debugObjects.destroyed[addr] = debugDestroyed{object, stack}
+		debugObjects.destroyedOrder = append(debugObjects.destroyedOrder,
+			debugDestroyedKey{addr, object.id})
+
+		for len(debugObjects.destroyedOrder) > debugDestroyedLimit {
+			key := debugObjects.destroyedOrder[0]
+			debugObjects.destroyedOrder = debugObjects.destroyedOrder[1:]
+
+			// The address may be reused by a later object.
+			d, ok := debugObjects.destroyed[key.addr]
+			if ok && (d.object.id == key.id) {
+				delete(debugObjects.destroyed, key.addr)
+			}
+		}
+	}
+}
+
+// debugPanicDestroyed is called when a destroyed wrapper is used.
+func debugPanicDestroyed(typeName string, p unsafe.Pointer) {
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "cairo: %s used after Destroy", typeName)
+
+	debugObjects.Lock()
+	d, ok := debugObjects.destroyed[uintptr(p)]
+	debugObjects.Unlock()
+
+	if ok {
+		fmt.Fprintf(&b, "\n\ncreated at:\n%s\ndestroyed at:\n%s", d.object.Stack, d.stack)
+	}
+
+	panic(b.String())
+}
+
+// LiveObjects returns the cairo objects which are neither destroyed nor
+// collected by the garbage collector, in the order of creation.
+// Unreachable objects are reported until their finalizers have run,
+// which runtime.GC triggers.
+// It returns nil if the package is built without the cairo_debug tag.
+func LiveObjects() []LiveObject {
+	if !debugEnabled {
+		return nil
+	}
+
+	debugObjects.Lock()
+	objects := make([]LiveObject, 0, len(debugObjects.live))
+	for _, object := range debugObjects.live {
+		objects = append(objects, object)
+	}
+	debugObjects.Unlock()
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].id < objects[j].id
+	})
+
+	return objects
+}
+
+// WriteLiveObjects writes the report of LiveObjects to w.
+func WriteLiveObjects(w io.Writer) error {
+
+	objects := LiveObjects()
+
+	_, err := fmt.Fprintf(w, "cairo: %d live objects\n", len(objects))
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		_, err = fmt.Fprintf(w, "\n%s created at:\n%s", object.Type, object.Stack)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
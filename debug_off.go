@@ -0,0 +1,7 @@
+//go:build !cairo_debug
+// +build !cairo_debug
+
+package cairo
+
+// debugEnabled is set by the cairo_debug build tag.
+const debugEnabled = false
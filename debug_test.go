@@ -0,0 +1,90 @@
+//go:build cairo_debug
+// +build cairo_debug
+
+package cairo
+
+import (
+	"strings"
+	"testing"
+)
+
+func expectDestroyedPanic(t *testing.T, name string, f func()) {
+	t.Helper()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("%s: no panic", name)
+			return
+		}
+		message, ok := r.(string)
+		if !ok || !strings.Contains(message, "used after Destroy") {
+			t.Errorf("%s: unexpected panic %v", name, r)
+		}
+	}()
+
+	f()
+}
+
+func TestDebugDestroyedArgument(t *testing.T) {
+
+	s, c := newTestCanvas(t)
+	defer s.Destroy()
+	defer c.Destroy()
+
+	p, err := NewPatternLinear(0, 0, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Destroy()
+
+	expectDestroyedPanic(t, "SetSource", func() {
+		c.SetSource(p)
+	})
+
+	if status := c.Status(); status != STATUS_SUCCESS {
+		t.Errorf("canvas status %v after SetSource of a destroyed pattern", status)
+	}
+}
+
+func TestDebugDestroyedReceiver(t *testing.T) {
+
+	s, c := newTestCanvas(t)
+	defer s.Destroy()
+
+	c.Destroy()
+
+	expectDestroyedPanic(t, "Paint", func() {
+		c.Paint()
+	})
+	expectDestroyedPanic(t, "Destroy", func() {
+		c.Destroy()
+	})
+}
+
+func TestDebugLiveObjects(t *testing.T) {
+
+	s, err := NewSurface(FORMAT_ARGB32, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := func() bool {
+		for _, object := range LiveObjects() {
+			if strings.Contains(object.Stack, "TestDebugLiveObjects") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !found() {
+		t.Error("the surface is not in LiveObjects")
+	}
+
+	s.Destroy()
+
+	if found() {
+		t.Error("the destroyed surface is in LiveObjects")
+	}
+}
@@ -0,0 +1,222 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdint.h>
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include "stream.h"
+//
+// static cairo_status_t device_observer_print(cairo_device_t *device, uintptr_t handle)
+// {
+//     return cairo_device_observer_print(device, stream_write, (void *)handle);
+// }
+import "C"
+
+import (
+	"io"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// Device is the rendering backend shared by surfaces, for example
+// a connection to an X server or a script writer.
+type Device struct {
+	deviceNative *C.cairo_device_t
+}
+
+func newDevice(deviceNative *C.cairo_device_t) (*Device, error) {
+
+	err := checkCairoStatus(C.cairo_device_status(deviceNative))
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Device{deviceNative}
+	debugTrack("Device", unsafe.Pointer(d))
+
+	runtime.SetFinalizer(d, (*Device).destroy)
+
+	return d, nil
+}
+
+func (d *Device) destroy() {
+	C.cairo_device_destroy(d.deviceNative)
+	debugUntrack(unsafe.Pointer(d), false)
+}
+
+func (d *Device) Destroy() {
+
+	if d.deviceNative == nil {
+		d.debugCheck()
+		return
+	}
+	debugUntrack(unsafe.Pointer(d), true)
+	d.destroy()
+	d.deviceNative = nil
+
+	runtime.SetFinalizer(d, nil)
+}
+
+func (d *Device) debugCheck() {
+	if debugEnabled && d.deviceNative == nil {
+		debugPanicDestroyed("Device", unsafe.Pointer(d))
+	}
+}
+
+func (d *Device) isDestroyed() bool {
+	d.debugCheck()
+	return d.deviceNative == nil
+}
+
+func NewDeviceNative(ptr uintptr) (*Device, error) {
+
+	deviceNative := (*C.cairo_device_t)(unsafe.Pointer(ptr))
+	reference := C.cairo_device_reference(deviceNative)
+
+	return newDevice(reference)
+}
+
+func (d *Device) Native() uintptr {
+	return uintptr(unsafe.Pointer(d.deviceNative))
+}
+
+func (d *Device) Reference() *Device {
+
+	if d.isDestroyed() {
+		return nil
+	}
+
+	reference := C.cairo_device_reference(d.deviceNative)
+
+	dr, err := newDevice(reference)
+	if err != nil {
+		C.cairo_device_destroy(reference)
+		return nil
+	}
+
+	return dr
+}
+
+func (d *Device) GetReferenceCount() uint {
+	if d.isDestroyed() {
+		return 0
+	}
+	return uint(C.cairo_device_get_reference_count(d.deviceNative))
+}
+
+func (d *Device) Status() Status {
+	if d.isDestroyed() {
+		return STATUS_NULL_POINTER
+	}
+	return Status(C.cairo_device_status(d.deviceNative))
+}
+
+func (d *Device) GetType() DeviceType {
+	if d.isDestroyed() {
+		return 0
+	}
+	return DeviceType(C.cairo_device_get_type(d.deviceNative))
+}
+
+// Acquire gets exclusive access to the device for direct use of the
+// underlying backend. It must be followed by Release.
+func (d *Device) Acquire() error {
+	if d.isDestroyed() {
+		return ErrDestroyed
+	}
+	return checkCairoStatus(C.cairo_device_acquire(d.deviceNative))
+}
+
+func (d *Device) Release() {
+	if d.isDestroyed() {
+		return
+	}
+	C.cairo_device_release(d.deviceNative)
+}
+
+func (d *Device) Flush() {
+	if d.isDestroyed() {
+		return
+	}
+	C.cairo_device_flush(d.deviceNative)
+}
+
+// Finish flushes the device and releases its backend resources.
+// The device object itself is released by Destroy.
+func (d *Device) Finish() {
+	if d.isDestroyed() {
+		return
+	}
+	C.cairo_device_finish(d.deviceNative)
+}
+
+// The observer getters work only with the device of an ObserverSurface.
+
+func observerElapsed(ns C.double) (time.Duration, error) {
+	if ns < 0 {
+		return 0, checkStatus(STATUS_DEVICE_TYPE_MISMATCH)
+	}
+	return time.Duration(ns), nil
+}
+
+func (d *Device) ObserverElapsed() (time.Duration, error) {
+	if d.isDestroyed() {
+		return 0, ErrDestroyed
+	}
+	return observerElapsed(C.cairo_device_observer_elapsed(d.deviceNative))
+}
+
+func (d *Device) ObserverPaintElapsed() (time.Duration, error) {
+	if d.isDestroyed() {
+		return 0, ErrDestroyed
+	}
+	return observerElapsed(C.cairo_device_observer_paint_elapsed(d.deviceNative))
+}
+
+func (d *Device) ObserverMaskElapsed() (time.Duration, error) {
+	if d.isDestroyed() {
+		return 0, ErrDestroyed
+	}
+	return observerElapsed(C.cairo_device_observer_mask_elapsed(d.deviceNative))
+}
+
+func (d *Device) ObserverFillElapsed() (time.Duration, error) {
+	if d.isDestroyed() {
+		return 0, ErrDestroyed
+	}
+	return observerElapsed(C.cairo_device_observer_fill_elapsed(d.deviceNative))
+}
+
+func (d *Device) ObserverStrokeElapsed() (time.Duration, error) {
+	if d.isDestroyed() {
+		return 0, ErrDestroyed
+	}
+	return observerElapsed(C.cairo_device_observer_stroke_elapsed(d.deviceNative))
+}
+
+func (d *Device) ObserverGlyphsElapsed() (time.Duration, error) {
+	if d.isDestroyed() {
+		return 0, ErrDestroyed
+	}
+	return observerElapsed(C.cairo_device_observer_glyphs_elapsed(d.deviceNative))
+}
+
+// ObserverPrint writes the statistics of the observer device in a human readable form.
+func (d *Device) ObserverPrint(w io.Writer) error {
+
+	if d.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	writer := &streamWriter{w: w}
+	h := newHandle(writer)
+	defer releaseHandle(h)
+
+	err := checkCairoStatus(C.device_observer_print(d.deviceNative, C.uintptr_t(h)))
+	if writer.Err() != nil {
+		return writer.Err()
+	}
+	return err
+}
@@ -6,6 +6,8 @@ package cairo
 // #include <cairo-gobject.h>
 import "C"
 
+import "fmt"
+
 type Format int // cairo_format_t
 
 const (
@@ -18,6 +20,85 @@ const (
 	FORMAT_RGB30     Format = C.CAIRO_FORMAT_RGB30
 )
 
+var formatNames = map[Format]string{
+	FORMAT_INVALID:   "invalid",
+	FORMAT_ARGB32:    "argb32",
+	FORMAT_RGB24:     "rgb24",
+	FORMAT_A8:        "a8",
+	FORMAT_A1:        "a1",
+	FORMAT_RGB16_565: "rgb16_565",
+	FORMAT_RGB30:     "rgb30",
+}
+
+func (f Format) String() string {
+	name, ok := formatNames[f]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "Format", int(f))
+}
+
+func (f Format) MarshalText() ([]byte, error) {
+	name, ok := formatNames[f]
+	if !ok {
+		return nil, errEnumValue("Format", int(f))
+	}
+	return []byte(name), nil
+}
+
+func (f *Format) UnmarshalText(text []byte) error {
+	for value, name := range formatNames {
+		if name == string(text) {
+			*f = value
+			return nil
+		}
+	}
+	return errEnumText("Format", text)
+}
+
+// StrideForWidth returns the stride of an image surface with the given width,
+// or -1 if the format is invalid or the width is too large.
+func (f Format) StrideForWidth(width int) int {
+	return int(C.cairo_format_stride_for_width(C.cairo_format_t(f), C.int(width)))
+}
+
+func (f Format) BitsPerPixel() int {
+	switch f {
+	case FORMAT_ARGB32, FORMAT_RGB24, FORMAT_RGB30:
+		return 32
+	case FORMAT_RGB16_565:
+		return 16
+	case FORMAT_A8:
+		return 8
+	case FORMAT_A1:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (f Format) HasAlpha() bool {
+	switch f {
+	case FORMAT_ARGB32, FORMAT_A8, FORMAT_A1:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f Format) Content() Content {
+	switch f {
+	case FORMAT_ARGB32:
+		return CONTENT_COLOR_ALPHA
+	case FORMAT_RGB24, FORMAT_RGB16_565, FORMAT_RGB30:
+		return CONTENT_COLOR
+	case FORMAT_A8, FORMAT_A1:
+		return CONTENT_ALPHA
+	default:
+		return 0
+	}
+}
+
 type LineJoin int // cairo_line_join_t
 
 const (
@@ -26,6 +107,38 @@ const (
 	LINE_JOIN_BEVEL LineJoin = C.CAIRO_LINE_JOIN_BEVEL
 )
 
+var lineJoinNames = map[LineJoin]string{
+	LINE_JOIN_MITER: "miter",
+	LINE_JOIN_ROUND: "round",
+	LINE_JOIN_BEVEL: "bevel",
+}
+
+func (l LineJoin) String() string {
+	name, ok := lineJoinNames[l]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "LineJoin", int(l))
+}
+
+func (l LineJoin) MarshalText() ([]byte, error) {
+	name, ok := lineJoinNames[l]
+	if !ok {
+		return nil, errEnumValue("LineJoin", int(l))
+	}
+	return []byte(name), nil
+}
+
+func (l *LineJoin) UnmarshalText(text []byte) error {
+	for value, name := range lineJoinNames {
+		if name == string(text) {
+			*l = value
+			return nil
+		}
+	}
+	return errEnumText("LineJoin", text)
+}
+
 type LineCap int // cairo_line_cap_t
 
 const (
@@ -34,6 +147,38 @@ const (
 	LINE_CAP_SQUARE LineCap = C.CAIRO_LINE_CAP_SQUARE
 )
 
+var lineCapNames = map[LineCap]string{
+	LINE_CAP_BUTT:   "butt",
+	LINE_CAP_ROUND:  "round",
+	LINE_CAP_SQUARE: "square",
+}
+
+func (l LineCap) String() string {
+	name, ok := lineCapNames[l]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "LineCap", int(l))
+}
+
+func (l LineCap) MarshalText() ([]byte, error) {
+	name, ok := lineCapNames[l]
+	if !ok {
+		return nil, errEnumValue("LineCap", int(l))
+	}
+	return []byte(name), nil
+}
+
+func (l *LineCap) UnmarshalText(text []byte) error {
+	for value, name := range lineCapNames {
+		if name == string(text) {
+			*l = value
+			return nil
+		}
+	}
+	return errEnumText("LineCap", text)
+}
+
 type FillRule int // cairo_fill_rule_t
 
 const (
@@ -41,6 +186,37 @@ const (
 	FILL_RULE_EVEN_ODD FillRule = C.CAIRO_FILL_RULE_EVEN_ODD
 )
 
+var fillRuleNames = map[FillRule]string{
+	FILL_RULE_WINDING:  "winding",
+	FILL_RULE_EVEN_ODD: "even_odd",
+}
+
+func (f FillRule) String() string {
+	name, ok := fillRuleNames[f]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "FillRule", int(f))
+}
+
+func (f FillRule) MarshalText() ([]byte, error) {
+	name, ok := fillRuleNames[f]
+	if !ok {
+		return nil, errEnumValue("FillRule", int(f))
+	}
+	return []byte(name), nil
+}
+
+func (f *FillRule) UnmarshalText(text []byte) error {
+	for value, name := range fillRuleNames {
+		if name == string(text) {
+			*f = value
+			return nil
+		}
+	}
+	return errEnumText("FillRule", text)
+}
+
 type Extend int // cairo_extend_t
 
 const (
@@ -50,6 +226,39 @@ const (
 	EXTEND_PAD     Extend = C.CAIRO_EXTEND_PAD
 )
 
+var extendNames = map[Extend]string{
+	EXTEND_NONE:    "none",
+	EXTEND_REPEAT:  "repeat",
+	EXTEND_REFLECT: "reflect",
+	EXTEND_PAD:     "pad",
+}
+
+func (e Extend) String() string {
+	name, ok := extendNames[e]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "Extend", int(e))
+}
+
+func (e Extend) MarshalText() ([]byte, error) {
+	name, ok := extendNames[e]
+	if !ok {
+		return nil, errEnumValue("Extend", int(e))
+	}
+	return []byte(name), nil
+}
+
+func (e *Extend) UnmarshalText(text []byte) error {
+	for value, name := range extendNames {
+		if name == string(text) {
+			*e = value
+			return nil
+		}
+	}
+	return errEnumText("Extend", text)
+}
+
 type FontSlant int // cairo_font_slant_t
 
 const (
@@ -58,6 +267,38 @@ const (
 	FONT_SLANT_OBLIQUE FontSlant = C.CAIRO_FONT_SLANT_OBLIQUE
 )
 
+var fontSlantNames = map[FontSlant]string{
+	FONT_SLANT_NORMAL:  "normal",
+	FONT_SLANT_ITALIC:  "italic",
+	FONT_SLANT_OBLIQUE: "oblique",
+}
+
+func (f FontSlant) String() string {
+	name, ok := fontSlantNames[f]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "FontSlant", int(f))
+}
+
+func (f FontSlant) MarshalText() ([]byte, error) {
+	name, ok := fontSlantNames[f]
+	if !ok {
+		return nil, errEnumValue("FontSlant", int(f))
+	}
+	return []byte(name), nil
+}
+
+func (f *FontSlant) UnmarshalText(text []byte) error {
+	for value, name := range fontSlantNames {
+		if name == string(text) {
+			*f = value
+			return nil
+		}
+	}
+	return errEnumText("FontSlant", text)
+}
+
 type FontWeight int // cairo_font_weight_t
 
 const (
@@ -65,6 +306,37 @@ const (
 	FONT_WEIGHT_BOLD   FontWeight = C.CAIRO_FONT_WEIGHT_BOLD
 )
 
+var fontWeightNames = map[FontWeight]string{
+	FONT_WEIGHT_NORMAL: "normal",
+	FONT_WEIGHT_BOLD:   "bold",
+}
+
+func (f FontWeight) String() string {
+	name, ok := fontWeightNames[f]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "FontWeight", int(f))
+}
+
+func (f FontWeight) MarshalText() ([]byte, error) {
+	name, ok := fontWeightNames[f]
+	if !ok {
+		return nil, errEnumValue("FontWeight", int(f))
+	}
+	return []byte(name), nil
+}
+
+func (f *FontWeight) UnmarshalText(text []byte) error {
+	for value, name := range fontWeightNames {
+		if name == string(text) {
+			*f = value
+			return nil
+		}
+	}
+	return errEnumText("FontWeight", text)
+}
+
 type Antialias int // cairo_antialias_t
 
 const (
@@ -77,6 +349,42 @@ const (
 	ANTIALIAS_BEST     Antialias = C.CAIRO_ANTIALIAS_BEST
 )
 
+var antialiasNames = map[Antialias]string{
+	ANTIALIAS_DEFAULT:  "default",
+	ANTIALIAS_NONE:     "none",
+	ANTIALIAS_GRAY:     "gray",
+	ANTIALIAS_SUBPIXEL: "subpixel",
+	ANTIALIAS_FAST:     "fast",
+	ANTIALIAS_GOOD:     "good",
+	ANTIALIAS_BEST:     "best",
+}
+
+func (a Antialias) String() string {
+	name, ok := antialiasNames[a]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "Antialias", int(a))
+}
+
+func (a Antialias) MarshalText() ([]byte, error) {
+	name, ok := antialiasNames[a]
+	if !ok {
+		return nil, errEnumValue("Antialias", int(a))
+	}
+	return []byte(name), nil
+}
+
+func (a *Antialias) UnmarshalText(text []byte) error {
+	for value, name := range antialiasNames {
+		if name == string(text) {
+			*a = value
+			return nil
+		}
+	}
+	return errEnumText("Antialias", text)
+}
+
 type Operator int // cairo_operator_t
 
 const (
@@ -111,6 +419,64 @@ const (
 	OPERATOR_HSL_LUMINOSITY Operator = C.CAIRO_OPERATOR_HSL_LUMINOSITY
 )
 
+var operatorNames = map[Operator]string{
+	OPERATOR_CLEAR:          "clear",
+	OPERATOR_SOURCE:         "source",
+	OPERATOR_OVER:           "over",
+	OPERATOR_IN:             "in",
+	OPERATOR_OUT:            "out",
+	OPERATOR_ATOP:           "atop",
+	OPERATOR_DEST:           "dest",
+	OPERATOR_DEST_OVER:      "dest_over",
+	OPERATOR_DEST_IN:        "dest_in",
+	OPERATOR_DEST_OUT:       "dest_out",
+	OPERATOR_DEST_ATOP:      "dest_atop",
+	OPERATOR_XOR:            "xor",
+	OPERATOR_ADD:            "add",
+	OPERATOR_SATURATE:       "saturate",
+	OPERATOR_MULTIPLY:       "multiply",
+	OPERATOR_SCREEN:         "screen",
+	OPERATOR_OVERLAY:        "overlay",
+	OPERATOR_DARKEN:         "darken",
+	OPERATOR_LIGHTEN:        "lighten",
+	OPERATOR_COLOR_DODGE:    "color_dodge",
+	OPERATOR_COLOR_BURN:     "color_burn",
+	OPERATOR_HARD_LIGHT:     "hard_light",
+	OPERATOR_SOFT_LIGHT:     "soft_light",
+	OPERATOR_DIFFERENCE:     "difference",
+	OPERATOR_EXCLUSION:      "exclusion",
+	OPERATOR_HSL_HUE:        "hsl_hue",
+	OPERATOR_HSL_SATURATION: "hsl_saturation",
+	OPERATOR_HSL_COLOR:      "hsl_color",
+	OPERATOR_HSL_LUMINOSITY: "hsl_luminosity",
+}
+
+func (o Operator) String() string {
+	name, ok := operatorNames[o]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "Operator", int(o))
+}
+
+func (o Operator) MarshalText() ([]byte, error) {
+	name, ok := operatorNames[o]
+	if !ok {
+		return nil, errEnumValue("Operator", int(o))
+	}
+	return []byte(name), nil
+}
+
+func (o *Operator) UnmarshalText(text []byte) error {
+	for value, name := range operatorNames {
+		if name == string(text) {
+			*o = value
+			return nil
+		}
+	}
+	return errEnumText("Operator", text)
+}
+
 type Content int // cairo_content_t
 
 const (
@@ -118,3 +484,315 @@ const (
 	CONTENT_ALPHA       Content = C.CAIRO_CONTENT_ALPHA
 	CONTENT_COLOR_ALPHA Content = C.CAIRO_CONTENT_COLOR_ALPHA
 )
+
+var contentNames = map[Content]string{
+	CONTENT_COLOR:       "color",
+	CONTENT_ALPHA:       "alpha",
+	CONTENT_COLOR_ALPHA: "color_alpha",
+}
+
+func (c Content) String() string {
+	name, ok := contentNames[c]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "Content", int(c))
+}
+
+func (c Content) MarshalText() ([]byte, error) {
+	name, ok := contentNames[c]
+	if !ok {
+		return nil, errEnumValue("Content", int(c))
+	}
+	return []byte(name), nil
+}
+
+func (c *Content) UnmarshalText(text []byte) error {
+	for value, name := range contentNames {
+		if name == string(text) {
+			*c = value
+			return nil
+		}
+	}
+	return errEnumText("Content", text)
+}
+
+type FontType int // cairo_font_type_t
+
+const (
+	FONT_TYPE_TOY    FontType = C.CAIRO_FONT_TYPE_TOY
+	FONT_TYPE_FT     FontType = C.CAIRO_FONT_TYPE_FT
+	FONT_TYPE_WIN32  FontType = C.CAIRO_FONT_TYPE_WIN32
+	FONT_TYPE_QUARTZ FontType = C.CAIRO_FONT_TYPE_QUARTZ
+	FONT_TYPE_USER   FontType = C.CAIRO_FONT_TYPE_USER
+)
+
+var fontTypeNames = map[FontType]string{
+	FONT_TYPE_TOY:    "toy",
+	FONT_TYPE_FT:     "ft",
+	FONT_TYPE_WIN32:  "win32",
+	FONT_TYPE_QUARTZ: "quartz",
+	FONT_TYPE_USER:   "user",
+}
+
+func (f FontType) String() string {
+	name, ok := fontTypeNames[f]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "FontType", int(f))
+}
+
+func (f FontType) MarshalText() ([]byte, error) {
+	name, ok := fontTypeNames[f]
+	if !ok {
+		return nil, errEnumValue("FontType", int(f))
+	}
+	return []byte(name), nil
+}
+
+func (f *FontType) UnmarshalText(text []byte) error {
+	for value, name := range fontTypeNames {
+		if name == string(text) {
+			*f = value
+			return nil
+		}
+	}
+	return errEnumText("FontType", text)
+}
+
+type SubpixelOrder int // cairo_subpixel_order_t
+
+const (
+	SUBPIXEL_ORDER_DEFAULT SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_DEFAULT
+	SUBPIXEL_ORDER_RGB     SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_RGB
+	SUBPIXEL_ORDER_BGR     SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_BGR
+	SUBPIXEL_ORDER_VRGB    SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_VRGB
+	SUBPIXEL_ORDER_VBGR    SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_VBGR
+)
+
+var subpixelOrderNames = map[SubpixelOrder]string{
+	SUBPIXEL_ORDER_DEFAULT: "default",
+	SUBPIXEL_ORDER_RGB:     "rgb",
+	SUBPIXEL_ORDER_BGR:     "bgr",
+	SUBPIXEL_ORDER_VRGB:    "vrgb",
+	SUBPIXEL_ORDER_VBGR:    "vbgr",
+}
+
+func (s SubpixelOrder) String() string {
+	name, ok := subpixelOrderNames[s]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "SubpixelOrder", int(s))
+}
+
+func (s SubpixelOrder) MarshalText() ([]byte, error) {
+	name, ok := subpixelOrderNames[s]
+	if !ok {
+		return nil, errEnumValue("SubpixelOrder", int(s))
+	}
+	return []byte(name), nil
+}
+
+func (s *SubpixelOrder) UnmarshalText(text []byte) error {
+	for value, name := range subpixelOrderNames {
+		if name == string(text) {
+			*s = value
+			return nil
+		}
+	}
+	return errEnumText("SubpixelOrder", text)
+}
+
+type HintStyle int // cairo_hint_style_t
+
+const (
+	HINT_STYLE_DEFAULT HintStyle = C.CAIRO_HINT_STYLE_DEFAULT
+	HINT_STYLE_NONE    HintStyle = C.CAIRO_HINT_STYLE_NONE
+	HINT_STYLE_SLIGHT  HintStyle = C.CAIRO_HINT_STYLE_SLIGHT
+	HINT_STYLE_MEDIUM  HintStyle = C.CAIRO_HINT_STYLE_MEDIUM
+	HINT_STYLE_FULL    HintStyle = C.CAIRO_HINT_STYLE_FULL
+)
+
+var hintStyleNames = map[HintStyle]string{
+	HINT_STYLE_DEFAULT: "default",
+	HINT_STYLE_NONE:    "none",
+	HINT_STYLE_SLIGHT:  "slight",
+	HINT_STYLE_MEDIUM:  "medium",
+	HINT_STYLE_FULL:    "full",
+}
+
+func (h HintStyle) String() string {
+	name, ok := hintStyleNames[h]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "HintStyle", int(h))
+}
+
+func (h HintStyle) MarshalText() ([]byte, error) {
+	name, ok := hintStyleNames[h]
+	if !ok {
+		return nil, errEnumValue("HintStyle", int(h))
+	}
+	return []byte(name), nil
+}
+
+func (h *HintStyle) UnmarshalText(text []byte) error {
+	for value, name := range hintStyleNames {
+		if name == string(text) {
+			*h = value
+			return nil
+		}
+	}
+	return errEnumText("HintStyle", text)
+}
+
+type HintMetrics int // cairo_hint_metrics_t
+
+const (
+	HINT_METRICS_DEFAULT HintMetrics = C.CAIRO_HINT_METRICS_DEFAULT
+	HINT_METRICS_OFF     HintMetrics = C.CAIRO_HINT_METRICS_OFF
+	HINT_METRICS_ON      HintMetrics = C.CAIRO_HINT_METRICS_ON
+)
+
+var hintMetricsNames = map[HintMetrics]string{
+	HINT_METRICS_DEFAULT: "default",
+	HINT_METRICS_OFF:     "off",
+	HINT_METRICS_ON:      "on",
+}
+
+func (h HintMetrics) String() string {
+	name, ok := hintMetricsNames[h]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "HintMetrics", int(h))
+}
+
+func (h HintMetrics) MarshalText() ([]byte, error) {
+	name, ok := hintMetricsNames[h]
+	if !ok {
+		return nil, errEnumValue("HintMetrics", int(h))
+	}
+	return []byte(name), nil
+}
+
+func (h *HintMetrics) UnmarshalText(text []byte) error {
+	for value, name := range hintMetricsNames {
+		if name == string(text) {
+			*h = value
+			return nil
+		}
+	}
+	return errEnumText("HintMetrics", text)
+}
+
+type DeviceType int // cairo_device_type_t
+
+const (
+	DEVICE_TYPE_DRM     DeviceType = C.CAIRO_DEVICE_TYPE_DRM
+	DEVICE_TYPE_GL      DeviceType = C.CAIRO_DEVICE_TYPE_GL
+	DEVICE_TYPE_SCRIPT  DeviceType = C.CAIRO_DEVICE_TYPE_SCRIPT
+	DEVICE_TYPE_XCB     DeviceType = C.CAIRO_DEVICE_TYPE_XCB
+	DEVICE_TYPE_XLIB    DeviceType = C.CAIRO_DEVICE_TYPE_XLIB
+	DEVICE_TYPE_XML     DeviceType = C.CAIRO_DEVICE_TYPE_XML
+	DEVICE_TYPE_COGL    DeviceType = C.CAIRO_DEVICE_TYPE_COGL
+	DEVICE_TYPE_WIN32   DeviceType = C.CAIRO_DEVICE_TYPE_WIN32
+	DEVICE_TYPE_INVALID DeviceType = C.CAIRO_DEVICE_TYPE_INVALID
+)
+
+var deviceTypeNames = map[DeviceType]string{
+	DEVICE_TYPE_DRM:     "drm",
+	DEVICE_TYPE_GL:      "gl",
+	DEVICE_TYPE_SCRIPT:  "script",
+	DEVICE_TYPE_XCB:     "xcb",
+	DEVICE_TYPE_XLIB:    "xlib",
+	DEVICE_TYPE_XML:     "xml",
+	DEVICE_TYPE_COGL:    "cogl",
+	DEVICE_TYPE_WIN32:   "win32",
+	DEVICE_TYPE_INVALID: "invalid",
+}
+
+func (d DeviceType) String() string {
+	name, ok := deviceTypeNames[d]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "DeviceType", int(d))
+}
+
+func (d DeviceType) MarshalText() ([]byte, error) {
+	name, ok := deviceTypeNames[d]
+	if !ok {
+		return nil, errEnumValue("DeviceType", int(d))
+	}
+	return []byte(name), nil
+}
+
+func (d *DeviceType) UnmarshalText(text []byte) error {
+	for value, name := range deviceTypeNames {
+		if name == string(text) {
+			*d = value
+			return nil
+		}
+	}
+	return errEnumText("DeviceType", text)
+}
+
+type PathDataType int // cairo_path_data_type_t
+
+const (
+	PATH_MOVE_TO    PathDataType = C.CAIRO_PATH_MOVE_TO
+	PATH_LINE_TO    PathDataType = C.CAIRO_PATH_LINE_TO
+	PATH_CURVE_TO   PathDataType = C.CAIRO_PATH_CURVE_TO
+	PATH_CLOSE_PATH PathDataType = C.CAIRO_PATH_CLOSE_PATH
+)
+
+var pathDataTypeNames = map[PathDataType]string{
+	PATH_MOVE_TO:    "move_to",
+	PATH_LINE_TO:    "line_to",
+	PATH_CURVE_TO:   "curve_to",
+	PATH_CLOSE_PATH: "close_path",
+}
+
+func (p PathDataType) String() string {
+	name, ok := pathDataTypeNames[p]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "PathDataType", int(p))
+}
+
+func (p PathDataType) MarshalText() ([]byte, error) {
+	name, ok := pathDataTypeNames[p]
+	if !ok {
+		return nil, errEnumValue("PathDataType", int(p))
+	}
+	return []byte(name), nil
+}
+
+func (p *PathDataType) UnmarshalText(text []byte) error {
+	for value, name := range pathDataTypeNames {
+		if name == string(text) {
+			*p = value
+			return nil
+		}
+	}
+	return errEnumText("PathDataType", text)
+}
+
+type TextClusterFlags int // cairo_text_cluster_flags_t
+
+const (
+	TEXT_CLUSTER_FLAG_BACKWARD TextClusterFlags = C.CAIRO_TEXT_CLUSTER_FLAG_BACKWARD
+)
+
+func errEnumValue(typeName string, value int) error {
+	return newCairoError(fmt.Sprintf("invalid %s value %d", typeName, value))
+}
+
+func errEnumText(typeName string, text []byte) error {
+	return newCairoError(fmt.Sprintf("invalid %s name %q", typeName, text))
+}
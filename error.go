@@ -5,6 +5,12 @@ import (
 	"fmt"
 )
 
+// ErrDestroyed is returned by the methods of an object called after Destroy.
+// Such methods don't pass the NULL pointer to cairo: they check isDestroyed of
+// the receiver and of the wrappers passed as arguments first, and return zero
+// values or ErrDestroyed. In debug mode debugCheck panics instead.
+var ErrDestroyed = errors.New("cairo: object is destroyed")
+
 func newCairoError(message string) error {
 	return errors.New(fmt.Sprintf("cairo: %s", message))
 }
@@ -502,26 +502,26 @@ func sampleText(c *cairo.Canvas) error {
 
 func sampleTextAlignCenter(c *cairo.Canvas) error {
 
-	var extents cairo.TextExtents
-
 	text := "cairo"
-	var x, y float64
 
 	c.SelectFontFace("Sans", cairo.FONT_SLANT_NORMAL, cairo.FONT_WEIGHT_NORMAL)
 
 	c.SetFontSize(52.0)
-	c.TextExtents(text, &extents)
-	x = 128.0 - (extents.Width/2 + extents.BearingX)
-	y = 128.0 - (extents.Height/2 + extents.BearingY)
 
-	c.MoveTo(x, y)
-	c.ShowText(text)
+	layout := cairo.TextLayout{
+		Align:  cairo.TEXT_ALIGN_CENTER,
+		Anchor: cairo.TEXT_ANCHOR_MIDDLE,
+	}
+	box := layout.Layout(c, text, 128.0, 128.0)
+	c.ShowTextBox(box)
 
 	// draw helping lines
 	c.SetSourceRGBA(1, 0.2, 0.2, 0.6)
 	c.SetLineWidth(6.0)
-	c.Arc(x, y, 10.0, 0, 2*math.Pi)
-	c.Fill()
+	for _, span := range box.Spans {
+		c.Arc(span.X, span.Y, 10.0, 0, 2*math.Pi)
+		c.Fill()
+	}
 	c.MoveTo(128.0, 0)
 	c.RelLineTo(0, 256)
 	c.MoveTo(0, 128.0)
@@ -4,6 +4,7 @@ import (
 	"image"
 	"log"
 	"math"
+	"strings"
 
 	"github.com/gitchander/cairo"
 	"github.com/gitchander/cairo/examples/mathf"
@@ -94,42 +95,17 @@ func minInt(a, b int) int {
 	return b
 }
 
-func maxFloat64(a, b float64) float64 {
-	if a > b {
-		return a
-	}
-	return b
-}
-
 func drawLines(c *cairo.Canvas, lines []string, center mathf.Point2f) {
 
-	ts := make([]*cairo.TextExtents, len(lines))
-	for i, line := range lines {
-		t := new(cairo.TextExtents)
-		c.TextExtents(line, t)
-		ts[i] = t
+	layout := cairo.TextLayout{
+		Align:       cairo.TEXT_ALIGN_CENTER,
+		Anchor:      cairo.TEXT_ANCHOR_MIDDLE,
+		LineSpacing: 1.2,
 	}
+	b := layout.Layout(c, strings.Join(lines, "\n"), center.X, center.Y)
 
-	var size mathf.Point2f
-	var dY float64
-	for _, t := range ts {
-		size.X = maxFloat64(size.X, t.Width)
-		size.Y += t.Height
-		dY = maxFloat64(dY, t.Height)
-	}
-	dY *= 0.5
-	size.Y += dY * float64(len(ts)-1)
-
-	p := center.Sub(size.DivScalar(2))
-	if len(ts) > 0 {
-		p.Y += ts[0].Height
-	}
-	for i, t := range ts {
-		c.MoveTo(p.X, p.Y)
-		c.SetSourceRGB(0.1, 0.0, 0.5)
-		c.ShowText(lines[i])
-		p.Y += t.Height + dY
-	}
+	c.SetSourceRGB(0.1, 0.0, 0.5)
+	c.ShowTextBox(b)
 }
 
 func drawPoint(c *cairo.Canvas, p mathf.Point2f) {
@@ -20,7 +20,9 @@ func checkError(err error) {
 }
 
 func run() error {
-	surface, err := cairo.NewSurface(cairo.FORMAT_ARGB32, 512, 512)
+	format := cairo.FORMAT_ARGB32
+
+	surface, err := cairo.NewSurface(format, 512, 512)
 	if err != nil {
 		return err
 	}
@@ -48,7 +50,7 @@ func run() error {
 	var (
 		width  = surface.GetWidth()
 		height = surface.GetHeight()
-		stride = surface.GetStride()
+		stride = format.StrideForWidth(width)
 	)
 
 	n := surface.GetDataLength()
@@ -59,7 +61,7 @@ func run() error {
 		return err
 	}
 
-	renderMSet(bs, width, height, stride, fg)
+	renderMSet(bs, width, height, stride, format.BitsPerPixel()/8, fg)
 
 	err = surface.SetData(bs)
 	if err != nil {
@@ -69,7 +71,7 @@ func run() error {
 	return surface.WriteToPNG("fractal.png")
 }
 
-func renderMSet(bs []byte, width, height, stride int, c color.Color) error {
+func renderMSet(bs []byte, width, height, stride, pixelSize int, c color.Color) error {
 
 	var (
 		dx = 4.0 / float64(width)
@@ -89,7 +91,7 @@ func renderMSet(bs []byte, width, height, stride int, c color.Color) error {
 		x := -2.0
 		for pX := 0; pX < width; pX++ {
 
-			i := pX * 4
+			i := pX * pixelSize
 			clBackground, err := coder.Decode(bs[i:])
 			if err != nil {
 				return err
@@ -0,0 +1,151 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+type FontFace struct {
+	fontFaceNative *C.cairo_font_face_t
+}
+
+func newFontFace(fontFaceNative *C.cairo_font_face_t) (*FontFace, error) {
+
+	err := checkCairoStatus(C.cairo_font_face_status(fontFaceNative))
+	if err != nil {
+		return nil, err
+	}
+
+	f := &FontFace{fontFaceNative}
+	debugTrack("FontFace", unsafe.Pointer(f))
+
+	runtime.SetFinalizer(f, (*FontFace).destroy)
+
+	return f, nil
+}
+
+func (f *FontFace) destroy() {
+	C.cairo_font_face_destroy(f.fontFaceNative)
+	debugUntrack(unsafe.Pointer(f), false)
+}
+
+func (f *FontFace) Destroy() {
+
+	if f.fontFaceNative == nil {
+		f.debugCheck()
+		return
+	}
+	debugUntrack(unsafe.Pointer(f), true)
+	f.destroy()
+	f.fontFaceNative = nil
+
+	runtime.SetFinalizer(f, nil)
+}
+
+func (f *FontFace) debugCheck() {
+	if debugEnabled && f.fontFaceNative == nil {
+		debugPanicDestroyed("FontFace", unsafe.Pointer(f))
+	}
+}
+
+func (f *FontFace) isDestroyed() bool {
+	f.debugCheck()
+	return f.fontFaceNative == nil
+}
+
+// NewToyFontFace creates a font face from a family name, slant and weight,
+// as used by Canvas.SelectFontFace.
+func NewToyFontFace(family string, fontSlant FontSlant, fontWeight FontWeight) (*FontFace, error) {
+
+	cstrFamily := newCString(family)
+	defer freeCString(cstrFamily)
+
+	fontFaceNative := C.cairo_toy_font_face_create(cstrFamily,
+		C.cairo_font_slant_t(fontSlant),
+		C.cairo_font_weight_t(fontWeight))
+
+	return newFontFace(fontFaceNative)
+}
+
+func NewFontFaceNative(ptr uintptr) (*FontFace, error) {
+
+	fontFaceNative := (*C.cairo_font_face_t)(unsafe.Pointer(ptr))
+	reference := C.cairo_font_face_reference(fontFaceNative)
+
+	return newFontFace(reference)
+}
+
+func (f *FontFace) Native() uintptr {
+	return uintptr(unsafe.Pointer(f.fontFaceNative))
+}
+
+func (f *FontFace) Reference() *FontFace {
+
+	if f.isDestroyed() {
+		return nil
+	}
+
+	reference := C.cairo_font_face_reference(f.fontFaceNative)
+
+	fr, err := newFontFace(reference)
+	if err != nil {
+		C.cairo_font_face_destroy(reference)
+		return nil
+	}
+
+	return fr
+}
+
+func (f *FontFace) GetReferenceCount() uint {
+	if f.isDestroyed() {
+		return 0
+	}
+	return uint(C.cairo_font_face_get_reference_count(f.fontFaceNative))
+}
+
+func (f *FontFace) Status() Status {
+	if f.isDestroyed() {
+		return STATUS_NULL_POINTER
+	}
+	return Status(C.cairo_font_face_status(f.fontFaceNative))
+}
+
+func (f *FontFace) GetType() FontType {
+	if f.isDestroyed() {
+		return 0
+	}
+	return FontType(C.cairo_font_face_get_type(f.fontFaceNative))
+}
+
+// The toy getters return zero values for other font types, on which cairo
+// would set STATUS_FONT_TYPE_MISMATCH.
+
+// GetFamily returns the family name of a toy font face.
+func (f *FontFace) GetFamily() string {
+	if f.isDestroyed() || (f.GetType() != FONT_TYPE_TOY) {
+		return ""
+	}
+	return C.GoString(C.cairo_toy_font_face_get_family(f.fontFaceNative))
+}
+
+// GetSlant returns the slant of a toy font face.
+func (f *FontFace) GetSlant() FontSlant {
+	if f.isDestroyed() || (f.GetType() != FONT_TYPE_TOY) {
+		return 0
+	}
+	return FontSlant(C.cairo_toy_font_face_get_slant(f.fontFaceNative))
+}
+
+// GetWeight returns the weight of a toy font face.
+func (f *FontFace) GetWeight() FontWeight {
+	if f.isDestroyed() || (f.GetType() != FONT_TYPE_TOY) {
+		return 0
+	}
+	return FontWeight(C.cairo_toy_font_face_get_weight(f.fontFaceNative))
+}
@@ -0,0 +1,168 @@
+//go:build cairo_ft
+// +build cairo_ft
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-ft freetype2
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-ft.h>
+// #include <ft2build.h>
+// #include FT_FREETYPE_H
+//
+// // Every font face owns its FreeType library, so that faces can be
+// // created and destroyed from different goroutines without locking.
+// typedef struct {
+//     FT_Library library;
+//     FT_Face    face;
+//     void      *data;
+// } ft_face_data_t;
+//
+// static cairo_user_data_key_t ft_face_data_key;
+//
+// static void ft_face_data_destroy(void *p)
+// {
+//     ft_face_data_t *d = p;
+//
+//     if (d->face != NULL) {
+//         FT_Done_Face(d->face);
+//     }
+//     if (d->library != NULL) {
+//         FT_Done_FreeType(d->library);
+//     }
+//     free(d->data);
+//     free(d);
+// }
+//
+// // ft_font_face_create takes the ownership of data.
+// static cairo_font_face_t *ft_font_face_create(const char *file_name,
+//     void *data, long size, long index, int load_flags, FT_Error *error)
+// {
+//     ft_face_data_t *d;
+//     cairo_font_face_t *font_face;
+//     cairo_status_t status;
+//
+//     d = calloc(1, sizeof(ft_face_data_t));
+//     if (d == NULL) {
+//         free(data);
+//         *error = FT_Err_Out_Of_Memory;
+//         return NULL;
+//     }
+//     d->data = data;
+//
+//     *error = FT_Init_FreeType(&d->library);
+//     if (*error != 0) {
+//         ft_face_data_destroy(d);
+//         return NULL;
+//     }
+//
+//     if (file_name != NULL) {
+//         *error = FT_New_Face(d->library, file_name, index, &d->face);
+//     } else {
+//         *error = FT_New_Memory_Face(d->library, data, size, index, &d->face);
+//     }
+//     if (*error != 0) {
+//         ft_face_data_destroy(d);
+//         return NULL;
+//     }
+//
+//     font_face = cairo_ft_font_face_create_for_ft_face(d->face, load_flags);
+//
+//     status = cairo_font_face_set_user_data(font_face, &ft_face_data_key, d, ft_face_data_destroy);
+//     if (status != CAIRO_STATUS_SUCCESS) {
+//         cairo_font_face_destroy(font_face);
+//         ft_face_data_destroy(d);
+//         *error = FT_Err_Out_Of_Memory;
+//         return NULL;
+//     }
+//
+//     return font_face;
+// }
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+type FTLoadFlags int // FreeType FT_LOAD_XXX flags
+
+const (
+	FT_LOAD_DEFAULT         FTLoadFlags = C.FT_LOAD_DEFAULT
+	FT_LOAD_NO_HINTING      FTLoadFlags = C.FT_LOAD_NO_HINTING
+	FT_LOAD_NO_BITMAP       FTLoadFlags = C.FT_LOAD_NO_BITMAP
+	FT_LOAD_VERTICAL_LAYOUT FTLoadFlags = C.FT_LOAD_VERTICAL_LAYOUT
+	FT_LOAD_FORCE_AUTOHINT  FTLoadFlags = C.FT_LOAD_FORCE_AUTOHINT
+	FT_LOAD_MONOCHROME      FTLoadFlags = C.FT_LOAD_MONOCHROME
+	FT_LOAD_NO_AUTOHINT     FTLoadFlags = C.FT_LOAD_NO_AUTOHINT
+)
+
+type FTSynthesize int // cairo_ft_synthesize_t
+
+const (
+	FT_SYNTHESIZE_BOLD    FTSynthesize = C.CAIRO_FT_SYNTHESIZE_BOLD
+	FT_SYNTHESIZE_OBLIQUE FTSynthesize = C.CAIRO_FT_SYNTHESIZE_OBLIQUE
+)
+
+// NewFontFaceFromFile loads the face with the given index from a font file
+// (TrueType, OpenType, ...) using FreeType.
+func NewFontFaceFromFile(fileName string, index int) (*FontFace, error) {
+	return NewFontFaceFromFileFlags(fileName, index, FT_LOAD_DEFAULT)
+}
+
+func NewFontFaceFromFileFlags(fileName string, index int, loadFlags FTLoadFlags) (*FontFace, error) {
+
+	cstr := newCString(fileName)
+	defer freeCString(cstr)
+
+	return newFontFaceFT(cstr, nil, 0, index, loadFlags)
+}
+
+// NewFontFaceFromBytes loads the first face from font file data using FreeType.
+// The data is copied, so the slice may be reused after the call.
+func NewFontFaceFromBytes(data []byte) (*FontFace, error) {
+	return NewFontFaceFromBytesFlags(data, 0, FT_LOAD_DEFAULT)
+}
+
+func NewFontFaceFromBytesFlags(data []byte, index int, loadFlags FTLoadFlags) (*FontFace, error) {
+
+	if len(data) == 0 {
+		return nil, newCairoError("NewFontFaceFromBytes(): empty font data")
+	}
+
+	return newFontFaceFT(nil, C.CBytes(data), len(data), index, loadFlags)
+}
+
+func newFontFaceFT(fileName *C.char, data unsafe.Pointer, size int, index int, loadFlags FTLoadFlags) (*FontFace, error) {
+
+	var ftError C.FT_Error
+
+	fontFaceNative := C.ft_font_face_create(fileName, data, C.long(size), C.long(index), C.int(loadFlags), &ftError)
+	if fontFaceNative == nil {
+		return nil, newCairoError(fmt.Sprintf("FreeType error %d", int(ftError)))
+	}
+
+	return newFontFace(fontFaceNative)
+}
+
+// SetSynthesize enables synthesizing of bold or oblique style for a FreeType font face.
+func (f *FontFace) SetSynthesize(synthFlags FTSynthesize) {
+	if f.isDestroyed() {
+		return
+	}
+	C.cairo_ft_font_face_set_synthesize(f.fontFaceNative, C.uint(synthFlags))
+}
+
+func (f *FontFace) UnsetSynthesize(synthFlags FTSynthesize) {
+	if f.isDestroyed() {
+		return
+	}
+	C.cairo_ft_font_face_unset_synthesize(f.fontFaceNative, C.uint(synthFlags))
+}
+
+func (f *FontFace) GetSynthesize() FTSynthesize {
+	if f.isDestroyed() {
+		return 0
+	}
+	return FTSynthesize(C.cairo_ft_font_face_get_synthesize(f.fontFaceNative))
+}
@@ -0,0 +1,188 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+type FontOptions struct {
+	fontOptionsNative *C.cairo_font_options_t
+}
+
+func newFontOptions(fontOptionsNative *C.cairo_font_options_t) (*FontOptions, error) {
+
+	err := checkCairoStatus(C.cairo_font_options_status(fontOptionsNative))
+	if err != nil {
+		return nil, err
+	}
+
+	o := &FontOptions{fontOptionsNative}
+	debugTrack("FontOptions", unsafe.Pointer(o))
+
+	runtime.SetFinalizer(o, (*FontOptions).destroy)
+
+	return o, nil
+}
+
+func (o *FontOptions) destroy() {
+	C.cairo_font_options_destroy(o.fontOptionsNative)
+	debugUntrack(unsafe.Pointer(o), false)
+}
+
+func (o *FontOptions) Destroy() {
+
+	if o.fontOptionsNative == nil {
+		o.debugCheck()
+		return
+	}
+	debugUntrack(unsafe.Pointer(o), true)
+	o.destroy()
+	o.fontOptionsNative = nil
+
+	runtime.SetFinalizer(o, nil)
+}
+
+func (o *FontOptions) debugCheck() {
+	if debugEnabled && o.fontOptionsNative == nil {
+		debugPanicDestroyed("FontOptions", unsafe.Pointer(o))
+	}
+}
+
+func (o *FontOptions) isDestroyed() bool {
+	o.debugCheck()
+	return o.fontOptionsNative == nil
+}
+
+// NewFontOptions creates font options with all options set to default values.
+func NewFontOptions() (*FontOptions, error) {
+	return newFontOptions(C.cairo_font_options_create())
+}
+
+func (o *FontOptions) Status() Status {
+	if o.isDestroyed() {
+		return STATUS_NULL_POINTER
+	}
+	return Status(C.cairo_font_options_status(o.fontOptionsNative))
+}
+
+func (o *FontOptions) Copy() (*FontOptions, error) {
+	if o.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+	return newFontOptions(C.cairo_font_options_copy(o.fontOptionsNative))
+}
+
+// Merge replaces the options of o with the non-default options of other.
+func (o *FontOptions) Merge(other *FontOptions) {
+	if o.isDestroyed() || other.isDestroyed() {
+		return
+	}
+	C.cairo_font_options_merge(o.fontOptionsNative, other.fontOptionsNative)
+}
+
+func (o *FontOptions) Equal(other *FontOptions) bool {
+	if o.isDestroyed() || other.isDestroyed() {
+		return false
+	}
+	return boolGolang(C.cairo_font_options_equal(o.fontOptionsNative, other.fontOptionsNative))
+}
+
+func (o *FontOptions) Hash() uint64 {
+	if o.isDestroyed() {
+		return 0
+	}
+	return uint64(C.cairo_font_options_hash(o.fontOptionsNative))
+}
+
+func (o *FontOptions) SetAntialias(antialias Antialias) {
+	if o.isDestroyed() {
+		return
+	}
+	C.cairo_font_options_set_antialias(o.fontOptionsNative, C.cairo_antialias_t(antialias))
+}
+
+func (o *FontOptions) GetAntialias() Antialias {
+	if o.isDestroyed() {
+		return 0
+	}
+	return Antialias(C.cairo_font_options_get_antialias(o.fontOptionsNative))
+}
+
+func (o *FontOptions) SetSubpixelOrder(subpixelOrder SubpixelOrder) {
+	if o.isDestroyed() {
+		return
+	}
+	C.cairo_font_options_set_subpixel_order(o.fontOptionsNative, C.cairo_subpixel_order_t(subpixelOrder))
+}
+
+func (o *FontOptions) GetSubpixelOrder() SubpixelOrder {
+	if o.isDestroyed() {
+		return 0
+	}
+	return SubpixelOrder(C.cairo_font_options_get_subpixel_order(o.fontOptionsNative))
+}
+
+func (o *FontOptions) SetHintStyle(hintStyle HintStyle) {
+	if o.isDestroyed() {
+		return
+	}
+	C.cairo_font_options_set_hint_style(o.fontOptionsNative, C.cairo_hint_style_t(hintStyle))
+}
+
+func (o *FontOptions) GetHintStyle() HintStyle {
+	if o.isDestroyed() {
+		return 0
+	}
+	return HintStyle(C.cairo_font_options_get_hint_style(o.fontOptionsNative))
+}
+
+func (o *FontOptions) SetHintMetrics(hintMetrics HintMetrics) {
+	if o.isDestroyed() {
+		return
+	}
+	C.cairo_font_options_set_hint_metrics(o.fontOptionsNative, C.cairo_hint_metrics_t(hintMetrics))
+}
+
+func (o *FontOptions) GetHintMetrics() HintMetrics {
+	if o.isDestroyed() {
+		return 0
+	}
+	return HintMetrics(C.cairo_font_options_get_hint_metrics(o.fontOptionsNative))
+}
+
+// SetVariations sets the OpenType font variations, for example "wght=700,wdth=75".
+func (o *FontOptions) SetVariations(variations string) {
+
+	if o.isDestroyed() {
+		return
+	}
+
+	if variations == "" {
+		C.cairo_font_options_set_variations(o.fontOptionsNative, nil)
+		return
+	}
+
+	cstr := newCString(variations)
+	defer freeCString(cstr)
+
+	C.cairo_font_options_set_variations(o.fontOptionsNative, cstr)
+}
+
+func (o *FontOptions) GetVariations() string {
+
+	if o.isDestroyed() {
+		return ""
+	}
+
+	cstr := C.cairo_font_options_get_variations(o.fontOptionsNative)
+	if cstr == nil {
+		return ""
+	}
+	return C.GoString(cstr)
+}
@@ -0,0 +1,247 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+
+import "unsafe"
+
+// Glyph holds a glyph index of a font and the position where the glyph
+// origin is placed in user space.
+type Glyph struct {
+	Index uint64
+	X, Y  float64
+}
+
+// TextCluster maps NumBytes bytes of UTF-8 text to NumGlyphs glyphs.
+type TextCluster struct {
+	NumBytes  int
+	NumGlyphs int
+}
+
+func glyphsToNative(glyphs []Glyph) []C.cairo_glyph_t {
+	gs := make([]C.cairo_glyph_t, len(glyphs))
+	for i, g := range glyphs {
+		gs[i] = C.cairo_glyph_t{
+			index: C.ulong(g.Index),
+			x:     C.double(g.X),
+			y:     C.double(g.Y),
+		}
+	}
+	return gs
+}
+
+func glyphsFromNative(p *C.cairo_glyph_t, n int) []Glyph {
+	if (p == nil) || (n <= 0) {
+		return nil
+	}
+	gs := (*[1 << 26]C.cairo_glyph_t)(unsafe.Pointer(p))[:n:n]
+	glyphs := make([]Glyph, n)
+	for i, g := range gs {
+		glyphs[i] = Glyph{
+			Index: uint64(g.index),
+			X:     float64(g.x),
+			Y:     float64(g.y),
+		}
+	}
+	return glyphs
+}
+
+func clustersToNative(clusters []TextCluster) []C.cairo_text_cluster_t {
+	cs := make([]C.cairo_text_cluster_t, len(clusters))
+	for i, c := range clusters {
+		cs[i] = C.cairo_text_cluster_t{
+			num_bytes:  C.int(c.NumBytes),
+			num_glyphs: C.int(c.NumGlyphs),
+		}
+	}
+	return cs
+}
+
+func clustersFromNative(p *C.cairo_text_cluster_t, n int) []TextCluster {
+	if (p == nil) || (n <= 0) {
+		return nil
+	}
+	cs := (*[1 << 26]C.cairo_text_cluster_t)(unsafe.Pointer(p))[:n:n]
+	clusters := make([]TextCluster, n)
+	for i, c := range cs {
+		clusters[i] = TextCluster{
+			NumBytes:  int(c.num_bytes),
+			NumGlyphs: int(c.num_glyphs),
+		}
+	}
+	return clusters
+}
+
+// glyphsToAllocated returns the glyphs copied to an array
+// allocated by cairo_glyph_allocate, or nil if there are no glyphs.
+func glyphsToAllocated(glyphs []Glyph) *C.cairo_glyph_t {
+	n := len(glyphs)
+	if n == 0 {
+		return nil
+	}
+	p := C.cairo_glyph_allocate(C.int(n))
+	if p == nil {
+		return nil
+	}
+	copy((*[1 << 26]C.cairo_glyph_t)(unsafe.Pointer(p))[:n:n], glyphsToNative(glyphs))
+	return p
+}
+
+// clustersToAllocated returns the clusters copied to an array
+// allocated by cairo_text_cluster_allocate, or nil if there are no clusters.
+func clustersToAllocated(clusters []TextCluster) *C.cairo_text_cluster_t {
+	n := len(clusters)
+	if n == 0 {
+		return nil
+	}
+	p := C.cairo_text_cluster_allocate(C.int(n))
+	if p == nil {
+		return nil
+	}
+	copy((*[1 << 26]C.cairo_text_cluster_t)(unsafe.Pointer(p))[:n:n], clustersToNative(clusters))
+	return p
+}
+
+func glyphsPointer(gs []C.cairo_glyph_t) *C.cairo_glyph_t {
+	if len(gs) == 0 {
+		return nil
+	}
+	return &gs[0]
+}
+
+func clustersPointer(cs []C.cairo_text_cluster_t) *C.cairo_text_cluster_t {
+	if len(cs) == 0 {
+		return nil
+	}
+	return &cs[0]
+}
+
+func (c *Canvas) ShowGlyphs(glyphs []Glyph) {
+
+	if c.isDestroyed() {
+		return
+	}
+
+	gs := glyphsToNative(glyphs)
+
+	C.cairo_show_glyphs(c.cr, glyphsPointer(gs), C.int(len(gs)))
+}
+
+// ShowTextGlyphs draws glyphs like ShowGlyphs, and in addition passes text
+// and the mapping of text to glyphs to backends that can store it
+// (for example PDF, for text selection and search).
+func (c *Canvas) ShowTextGlyphs(text string, glyphs []Glyph, clusters []TextCluster, flags TextClusterFlags) {
+
+	if c.isDestroyed() {
+		return
+	}
+
+	cstr := newCString(text)
+	defer freeCString(cstr)
+
+	var (
+		gs = glyphsToNative(glyphs)
+		cs = clustersToNative(clusters)
+	)
+
+	C.cairo_show_text_glyphs(c.cr,
+		cstr, C.int(len(text)),
+		glyphsPointer(gs), C.int(len(gs)),
+		clustersPointer(cs), C.int(len(cs)),
+		C.cairo_text_cluster_flags_t(flags))
+}
+
+func (c *Canvas) GlyphPath(glyphs []Glyph) {
+
+	if c.isDestroyed() {
+		return
+	}
+
+	gs := glyphsToNative(glyphs)
+
+	C.cairo_glyph_path(c.cr, glyphsPointer(gs), C.int(len(gs)))
+}
+
+func (c *Canvas) GlyphExtents(glyphs []Glyph, textExtents *TextExtents) {
+
+	if c.isDestroyed() {
+		return
+	}
+
+	if textExtents == nil {
+		return
+	}
+
+	gs := glyphsToNative(glyphs)
+
+	var extents C.cairo_text_extents_t
+
+	C.cairo_glyph_extents(c.cr, glyphsPointer(gs), C.int(len(gs)), &extents)
+
+	textExtents.setNative(&extents)
+}
+
+func (sf *ScaledFont) GlyphExtents(glyphs []Glyph, textExtents *TextExtents) {
+
+	if sf.isDestroyed() {
+		return
+	}
+
+	if textExtents == nil {
+		return
+	}
+
+	gs := glyphsToNative(glyphs)
+
+	var extents C.cairo_text_extents_t
+
+	C.cairo_scaled_font_glyph_extents(sf.scaledFontNative, glyphsPointer(gs), C.int(len(gs)), &extents)
+
+	textExtents.setNative(&extents)
+}
+
+// TextToGlyphs converts text to glyphs positioned starting at (x, y) in user space,
+// and returns the clusters mapping the text bytes to the glyphs.
+func (sf *ScaledFont) TextToGlyphs(x, y float64, text string) ([]Glyph, []TextCluster, TextClusterFlags, error) {
+
+	if sf.isDestroyed() {
+		return nil, nil, 0, ErrDestroyed
+	}
+
+	cstr := newCString(text)
+	defer freeCString(cstr)
+
+	var (
+		glyphsNative   *C.cairo_glyph_t
+		numGlyphs      C.int
+		clustersNative *C.cairo_text_cluster_t
+		numClusters    C.int
+		flags          C.cairo_text_cluster_flags_t
+	)
+
+	status := C.cairo_scaled_font_text_to_glyphs(sf.scaledFontNative,
+		C.double(x), C.double(y),
+		cstr, C.int(len(text)),
+		&glyphsNative, &numGlyphs,
+		&clustersNative, &numClusters,
+		&flags)
+
+	defer C.cairo_glyph_free(glyphsNative)
+	defer C.cairo_text_cluster_free(clustersNative)
+
+	err := userFontStatusError(C.cairo_scaled_font_get_font_face(sf.scaledFontNative),
+		sf.scaledFontNative, Status(status))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var (
+		glyphs   = glyphsFromNative(glyphsNative, int(numGlyphs))
+		clusters = clustersFromNative(clustersNative, int(numClusters))
+	)
+
+	return glyphs, clusters, TextClusterFlags(flags), nil
+}
@@ -0,0 +1,8 @@
+#include <stdint.h>
+#include "handle.h"
+#include "_cgo_export.h"
+
+void handle_release(void *data)
+{
+	goReleaseHandle((uintptr_t)data);
+}
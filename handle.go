@@ -0,0 +1,47 @@
+package cairo
+
+// #include <stdint.h>
+import "C"
+
+import "sync"
+
+// Go pointers can't be stored by C code, so Go values which are used by
+// cairo callbacks are kept in a table and passed to C as integer handles.
+var handles = struct {
+	sync.Mutex
+	values map[uintptr]interface{}
+	last   uintptr
+}{
+	values: make(map[uintptr]interface{}),
+}
+
+// newHandle stores v in the table and returns a non-zero handle of it.
+func newHandle(v interface{}) uintptr {
+	handles.Lock()
+	defer handles.Unlock()
+
+	handles.last++
+	h := handles.last
+	handles.values[h] = v
+
+	return h
+}
+
+func handleValue(h uintptr) interface{} {
+	handles.Lock()
+	defer handles.Unlock()
+
+	return handles.values[h]
+}
+
+func releaseHandle(h uintptr) {
+	handles.Lock()
+	defer handles.Unlock()
+
+	delete(handles.values, h)
+}
+
+//export goReleaseHandle
+func goReleaseHandle(h C.uintptr_t) {
+	releaseHandle(uintptr(h))
+}
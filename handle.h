@@ -0,0 +1,8 @@
+#ifndef GO_CAIRO_HANDLE_H
+#define GO_CAIRO_HANDLE_H
+
+// handle_release is a cairo_destroy_func_t releasing the Go handle
+// stored as the user data pointer.
+void handle_release(void *data);
+
+#endif
@@ -0,0 +1,98 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+//
+// static cairo_status_t surface_set_mime_data(cairo_surface_t *surface,
+//     const char *mime_type, unsigned char *data, unsigned long length)
+// {
+//     cairo_status_t status;
+//
+//     if (data == NULL) {
+//         return cairo_surface_set_mime_data(surface, mime_type, NULL, 0, NULL, NULL);
+//     }
+//
+//     status = cairo_surface_set_mime_data(surface, mime_type, data, length, free, data);
+//     if (status != CAIRO_STATUS_SUCCESS) {
+//         free(data);
+//     }
+//     return status;
+// }
+import "C"
+
+import "unsafe"
+
+const (
+	MIME_TYPE_JPEG             = "image/jpeg"
+	MIME_TYPE_PNG              = "image/png"
+	MIME_TYPE_JP2              = "image/jp2"
+	MIME_TYPE_URI              = "text/x-uri"
+	MIME_TYPE_UNIQUE_ID        = "application/x-cairo.uuid"
+	MIME_TYPE_JBIG2            = "application/x-cairo.jbig2"
+	MIME_TYPE_JBIG2_GLOBAL     = "application/x-cairo.jbig2-global"
+	MIME_TYPE_JBIG2_GLOBAL_ID  = "application/x-cairo.jbig2-global-id"
+	MIME_TYPE_CCITT_FAX        = "image/g3fax"
+	MIME_TYPE_CCITT_FAX_PARAMS = "application/x-cairo.ccitt.params"
+	MIME_TYPE_EPS              = "application/postscript"
+	MIME_TYPE_EPS_PARAMS       = "application/x-cairo.eps.params"
+)
+
+// SetMimeData attaches the encoded image data to the surface, so that vector
+// backends can embed it as is instead of re-encoding the pixels.
+// The data is copied to C memory which is released by cairo together with the surface.
+// Passing empty data removes the attachment for the mime type.
+func (s *Surface) SetMimeData(mimeType string, data []byte) error {
+
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	cstrMimeType := newCString(mimeType)
+	defer freeCString(cstrMimeType)
+
+	var dataNative unsafe.Pointer
+	if len(data) > 0 {
+		dataNative = C.CBytes(data)
+	}
+
+	return checkCairoStatus(C.surface_set_mime_data(s.surfaceNative, cstrMimeType,
+		(*C.uchar)(dataNative), C.ulong(len(data))))
+}
+
+// GetMimeData returns a copy of the data attached to the surface for the mime type
+// or nil if there is no such data.
+func (s *Surface) GetMimeData(mimeType string) []byte {
+
+	if s.isDestroyed() {
+		return nil
+	}
+
+	cstrMimeType := newCString(mimeType)
+	defer freeCString(cstrMimeType)
+
+	var (
+		dataNative *C.uchar
+		length     C.ulong
+	)
+
+	C.cairo_surface_get_mime_data(s.surfaceNative, cstrMimeType, &dataNative, &length)
+	if dataNative == nil {
+		return nil
+	}
+
+	return C.GoBytes(unsafe.Pointer(dataNative), C.int(length))
+}
+
+func (s *Surface) SupportsMimeType(mimeType string) bool {
+
+	if s.isDestroyed() {
+		return false
+	}
+
+	cstrMimeType := newCString(mimeType)
+	defer freeCString(cstrMimeType)
+
+	return boolGolang(C.cairo_surface_supports_mime_type(s.surfaceNative, cstrMimeType))
+}
@@ -0,0 +1,56 @@
+#include <stdint.h>
+#include <cairo.h>
+#include "handle.h"
+#include "stream.h"
+#include "observer.h"
+#include "_cgo_export.h"
+
+static cairo_user_data_key_t observer_key;
+
+#define OBSERVER_CALLBACK(name, event) \
+	static void name(cairo_surface_t *observer, cairo_surface_t *target, void *data) \
+	{ \
+		goObserverCallback((uintptr_t)data, observer, event); \
+	}
+
+OBSERVER_CALLBACK(observer_paint, OBSERVER_EVENT_PAINT)
+OBSERVER_CALLBACK(observer_mask, OBSERVER_EVENT_MASK)
+OBSERVER_CALLBACK(observer_fill, OBSERVER_EVENT_FILL)
+OBSERVER_CALLBACK(observer_stroke, OBSERVER_EVENT_STROKE)
+OBSERVER_CALLBACK(observer_glyphs, OBSERVER_EVENT_GLYPHS)
+OBSERVER_CALLBACK(observer_flush, OBSERVER_EVENT_FLUSH)
+OBSERVER_CALLBACK(observer_finish, OBSERVER_EVENT_FINISH)
+
+cairo_surface_t *observer_surface_create(cairo_surface_t *target,
+	cairo_surface_observer_mode_t mode, uintptr_t handle)
+{
+	cairo_surface_t *surface;
+	cairo_status_t status;
+	void *data = (void *)handle;
+
+	surface = cairo_surface_create_observer(target, mode);
+	if (cairo_surface_status(surface) != CAIRO_STATUS_SUCCESS) {
+		return surface;
+	}
+
+	status = cairo_surface_set_user_data(surface, &observer_key, data, handle_release);
+	if (status != CAIRO_STATUS_SUCCESS) {
+		cairo_surface_destroy(surface);
+		return NULL;
+	}
+
+	cairo_surface_observer_add_paint_callback(surface, observer_paint, data);
+	cairo_surface_observer_add_mask_callback(surface, observer_mask, data);
+	cairo_surface_observer_add_fill_callback(surface, observer_fill, data);
+	cairo_surface_observer_add_stroke_callback(surface, observer_stroke, data);
+	cairo_surface_observer_add_glyphs_callback(surface, observer_glyphs, data);
+	cairo_surface_observer_add_flush_callback(surface, observer_flush, data);
+	cairo_surface_observer_add_finish_callback(surface, observer_finish, data);
+
+	return surface;
+}
+
+cairo_status_t observer_surface_print(cairo_surface_t *surface, uintptr_t handle)
+{
+	return cairo_surface_observer_print(surface, stream_write, (void *)handle);
+}
@@ -0,0 +1,201 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdint.h>
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include "observer.h"
+import "C"
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+type SurfaceObserverMode int // cairo_surface_observer_mode_t
+
+const (
+	SURFACE_OBSERVER_NORMAL            SurfaceObserverMode = C.CAIRO_SURFACE_OBSERVER_NORMAL
+	SURFACE_OBSERVER_RECORD_OPERATIONS SurfaceObserverMode = C.CAIRO_SURFACE_OBSERVER_RECORD_OPERATIONS
+)
+
+type ObserverEvent int
+
+const (
+	OBSERVER_PAINT  ObserverEvent = C.OBSERVER_EVENT_PAINT
+	OBSERVER_MASK   ObserverEvent = C.OBSERVER_EVENT_MASK
+	OBSERVER_FILL   ObserverEvent = C.OBSERVER_EVENT_FILL
+	OBSERVER_STROKE ObserverEvent = C.OBSERVER_EVENT_STROKE
+	OBSERVER_GLYPHS ObserverEvent = C.OBSERVER_EVENT_GLYPHS
+	OBSERVER_FLUSH  ObserverEvent = C.OBSERVER_EVENT_FLUSH
+	OBSERVER_FINISH ObserverEvent = C.OBSERVER_EVENT_FINISH
+)
+
+const observerEventCount = C.OBSERVER_EVENT_COUNT
+
+var observerEventNames = map[ObserverEvent]string{
+	OBSERVER_PAINT:  "paint",
+	OBSERVER_MASK:   "mask",
+	OBSERVER_FILL:   "fill",
+	OBSERVER_STROKE: "stroke",
+	OBSERVER_GLYPHS: "glyphs",
+	OBSERVER_FLUSH:  "flush",
+	OBSERVER_FINISH: "finish",
+}
+
+func (e ObserverEvent) String() string {
+	name, ok := observerEventNames[e]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", "ObserverEvent", int(e))
+}
+
+// ObserverFunc is called after a drawing operation on an observer surface.
+// elapsed is the time spent by the operation; it is zero for flush and finish.
+type ObserverFunc func(event ObserverEvent, elapsed time.Duration)
+
+type observerState struct {
+	sync.Mutex
+	lastElapsed time.Duration
+	counts      [observerEventCount]int
+	elapsed     [observerEventCount]time.Duration
+	callbacks   [observerEventCount][]ObserverFunc
+	err         error // first panic of a callback
+}
+
+// call calls f, recovering a panic, because it must not unwind through cairo.
+func (state *observerState) call(f ObserverFunc, event ObserverEvent, elapsed time.Duration) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			state.Lock()
+			if state.err == nil {
+				state.err = fmt.Errorf("cairo: observer callback panic: %v", r)
+			}
+			state.Unlock()
+		}
+	}()
+
+	f(event, elapsed)
+}
+
+// ObserverSurface passes the drawing operations to the target surface,
+// measuring and counting them.
+type ObserverSurface struct {
+	*Surface
+	state *observerState
+}
+
+func NewObserverSurface(target *Surface, mode SurfaceObserverMode) (*ObserverSurface, error) {
+
+	if target.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	state := new(observerState)
+	h := newHandle(state)
+
+	surfaceNative := C.observer_surface_create(target.surfaceNative,
+		C.cairo_surface_observer_mode_t(mode), C.uintptr_t(h))
+	if surfaceNative == nil {
+		releaseHandle(h)
+		return nil, checkStatus(STATUS_NO_MEMORY)
+	}
+
+	s, err := newSurface(surfaceNative)
+	if err != nil {
+		releaseHandle(h)
+		return nil, err
+	}
+
+	return &ObserverSurface{s, state}, nil
+}
+
+//export goObserverCallback
+func goObserverCallback(h C.uintptr_t, observerNative *C.cairo_surface_t, event C.observer_event_t) {
+
+	state, ok := handleValue(uintptr(h)).(*observerState)
+	if !ok {
+		return
+	}
+
+	total := time.Duration(C.cairo_surface_observer_elapsed(observerNative))
+
+	state.Lock()
+	elapsed := total - state.lastElapsed
+	state.lastElapsed = total
+	state.counts[event]++
+	state.elapsed[event] += elapsed
+	callbacks := state.callbacks[event]
+	state.Unlock()
+
+	for _, f := range callbacks {
+		state.call(f, ObserverEvent(event), elapsed)
+	}
+}
+
+// AddCallback adds f to be called after each event operation.
+// A panic in f is recovered and returned by Err.
+func (s *ObserverSurface) AddCallback(event ObserverEvent, f ObserverFunc) {
+
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	s.state.callbacks[event] = append(s.state.callbacks[event], f)
+}
+
+// Err returns the first panic of a callback as an error.
+func (s *ObserverSurface) Err() error {
+
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	return s.state.err
+}
+
+// Count returns the number of event operations.
+func (s *ObserverSurface) Count(event ObserverEvent) int {
+
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	return s.state.counts[event]
+}
+
+// Elapsed returns the total time spent by event operations.
+func (s *ObserverSurface) Elapsed(event ObserverEvent) time.Duration {
+
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	return s.state.elapsed[event]
+}
+
+// TotalElapsed returns the total time spent by all operations.
+func (s *ObserverSurface) TotalElapsed() time.Duration {
+	if s.isDestroyed() {
+		return 0
+	}
+	return time.Duration(C.cairo_surface_observer_elapsed(s.surfaceNative))
+}
+
+// Print writes the statistics collected by cairo in a human readable form.
+func (s *ObserverSurface) Print(w io.Writer) error {
+
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	writer := &streamWriter{w: w}
+	h := newHandle(writer)
+	defer releaseHandle(h)
+
+	err := checkCairoStatus(C.observer_surface_print(s.surfaceNative, C.uintptr_t(h)))
+	if writer.Err() != nil {
+		return writer.Err()
+	}
+	return err
+}
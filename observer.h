@@ -0,0 +1,25 @@
+#ifndef GO_CAIRO_OBSERVER_H
+#define GO_CAIRO_OBSERVER_H
+
+#include <stdint.h>
+#include <cairo.h>
+
+typedef enum {
+	OBSERVER_EVENT_PAINT,
+	OBSERVER_EVENT_MASK,
+	OBSERVER_EVENT_FILL,
+	OBSERVER_EVENT_STROKE,
+	OBSERVER_EVENT_GLYPHS,
+	OBSERVER_EVENT_FLUSH,
+	OBSERVER_EVENT_FINISH,
+	OBSERVER_EVENT_COUNT
+} observer_event_t;
+
+// observer_surface_create creates an observer surface which callbacks
+// report to the Go observer state with the handle.
+cairo_surface_t *observer_surface_create(cairo_surface_t *target,
+	cairo_surface_observer_mode_t mode, uintptr_t handle);
+
+cairo_status_t observer_surface_print(cairo_surface_t *surface, uintptr_t handle);
+
+#endif
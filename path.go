@@ -0,0 +1,178 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+//
+// // cairo_path_data_t is a union, which is not accessible from Go.
+//
+// static cairo_path_data_type_t path_data_type(cairo_path_t *path, int i)
+// {
+//     return path->data[i].header.type;
+// }
+//
+// static int path_data_length(cairo_path_t *path, int i)
+// {
+//     return path->data[i].header.length;
+// }
+//
+// static double path_data_x(cairo_path_t *path, int i)
+// {
+//     return path->data[i].point.x;
+// }
+//
+// static double path_data_y(cairo_path_t *path, int i)
+// {
+//     return path->data[i].point.y;
+// }
+import "C"
+
+type PathPoint struct {
+	X, Y float64
+}
+
+// PathElement is a path operation with its points:
+// one point for PATH_MOVE_TO and PATH_LINE_TO, three points for PATH_CURVE_TO
+// and no points for PATH_CLOSE_PATH.
+type PathElement struct {
+	Type   PathDataType
+	Points []PathPoint
+}
+
+type Path struct {
+	Elements []PathElement
+}
+
+func (p *Path) MoveTo(x, y float64) {
+	p.Elements = append(p.Elements, PathElement{
+		Type:   PATH_MOVE_TO,
+		Points: []PathPoint{{x, y}},
+	})
+}
+
+func (p *Path) LineTo(x, y float64) {
+	p.Elements = append(p.Elements, PathElement{
+		Type:   PATH_LINE_TO,
+		Points: []PathPoint{{x, y}},
+	})
+}
+
+func (p *Path) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
+	p.Elements = append(p.Elements, PathElement{
+		Type:   PATH_CURVE_TO,
+		Points: []PathPoint{{x1, y1}, {x2, y2}, {x3, y3}},
+	})
+}
+
+func (p *Path) ClosePath() {
+	p.Elements = append(p.Elements, PathElement{
+		Type: PATH_CLOSE_PATH,
+	})
+}
+
+var pathDataPoints = map[PathDataType]int{
+	PATH_MOVE_TO:    1,
+	PATH_LINE_TO:    1,
+	PATH_CURVE_TO:   3,
+	PATH_CLOSE_PATH: 0,
+}
+
+// check returns STATUS_INVALID_PATH_DATA if an element has an unknown type
+// or too few points for its type.
+func (p *Path) check() error {
+	for _, e := range p.Elements {
+		n, ok := pathDataPoints[e.Type]
+		if !ok || (len(e.Points) < n) {
+			return checkStatus(STATUS_INVALID_PATH_DATA)
+		}
+	}
+	return nil
+}
+
+// CopyPath returns a copy of the current path in user space.
+func (c *Canvas) CopyPath() (*Path, error) {
+	if c.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+	return newPath(C.cairo_copy_path(c.cr))
+}
+
+// CopyPathFlat returns a copy of the current path with the curves
+// replaced by line segments.
+func (c *Canvas) CopyPathFlat() (*Path, error) {
+	if c.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+	return newPath(C.cairo_copy_path_flat(c.cr))
+}
+
+func newPath(pathNative *C.cairo_path_t) (*Path, error) {
+
+	defer C.cairo_path_destroy(pathNative)
+
+	err := checkCairoStatus(pathNative.status)
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(Path)
+
+	numData := int(pathNative.num_data)
+	for i := 0; i < numData; {
+
+		var (
+			dataType = PathDataType(C.path_data_type(pathNative, C.int(i)))
+			length   = int(C.path_data_length(pathNative, C.int(i)))
+		)
+
+		points := make([]PathPoint, length-1)
+		for j := range points {
+			points[j] = PathPoint{
+				X: float64(C.path_data_x(pathNative, C.int(i+1+j))),
+				Y: float64(C.path_data_y(pathNative, C.int(i+1+j))),
+			}
+		}
+
+		p.Elements = append(p.Elements, PathElement{
+			Type:   dataType,
+			Points: points,
+		})
+
+		i += length
+	}
+
+	return p, nil
+}
+
+// AppendPath adds the path to the current path.
+// Nothing is added if the path has an invalid element.
+func (c *Canvas) AppendPath(p *Path) error {
+
+	if c.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	err := p.check()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range p.Elements {
+		switch e.Type {
+		case PATH_MOVE_TO:
+			c.MoveTo(e.Points[0].X, e.Points[0].Y)
+		case PATH_LINE_TO:
+			c.LineTo(e.Points[0].X, e.Points[0].Y)
+		case PATH_CURVE_TO:
+			c.CurveTo(
+				e.Points[0].X, e.Points[0].Y,
+				e.Points[1].X, e.Points[1].Y,
+				e.Points[2].X, e.Points[2].Y)
+		case PATH_CLOSE_PATH:
+			c.ClosePath()
+		}
+	}
+
+	return nil
+}
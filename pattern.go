@@ -23,6 +23,7 @@ func newPattern(pattern_n *C.cairo_pattern_t) (*Pattern, error) {
 	}
 
 	p := &Pattern{pattern_n}
+	debugTrack("Pattern", unsafe.Pointer(p))
 
 	runtime.SetFinalizer(p, (*Pattern).destroy)
 
@@ -31,19 +32,33 @@ func newPattern(pattern_n *C.cairo_pattern_t) (*Pattern, error) {
 
 func (p *Pattern) destroy() {
 	C.cairo_pattern_destroy(p.pattern_n)
+	debugUntrack(unsafe.Pointer(p), false)
 }
 
 func (p *Pattern) Destroy() {
 
 	if p.pattern_n == nil {
+		p.debugCheck()
 		return
 	}
+	debugUntrack(unsafe.Pointer(p), true)
 	p.destroy()
 	p.pattern_n = nil
 
 	runtime.SetFinalizer(p, nil)
 }
 
+func (p *Pattern) debugCheck() {
+	if debugEnabled && p.pattern_n == nil {
+		debugPanicDestroyed("Pattern", unsafe.Pointer(p))
+	}
+}
+
+func (p *Pattern) isDestroyed() bool {
+	p.debugCheck()
+	return p.pattern_n == nil
+}
+
 func NewPatternLinear(x0, y0, x1, y1 float64) (*Pattern, error) {
 
 	pattern_n := C.cairo_pattern_create_linear(C.double(x0), C.double(y0), C.double(x1), C.double(y1))
@@ -62,6 +77,10 @@ func NewPatternRadial(cx0, cy0, radius0, cx1, cy1, radius1 float64) (*Pattern, e
 
 func NewPatternForSurface(s *Surface) (*Pattern, error) {
 
+	if s.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
 	pattern_n := C.cairo_pattern_create_for_surface(s.surfaceNative)
 
 	return newPattern(pattern_n)
@@ -81,25 +100,52 @@ func (p *Pattern) Native() uintptr {
 
 func (p *Pattern) Reference() *Pattern {
 
+	if p.isDestroyed() {
+		return nil
+	}
+
 	reference := C.cairo_pattern_reference(p.pattern_n)
 
-	pr, _ := newPattern(reference)
+	pr, err := newPattern(reference)
+	if err != nil {
+		C.cairo_pattern_destroy(reference)
+		return nil
+	}
 
 	return pr
 }
 
+func (p *Pattern) GetReferenceCount() uint {
+	if p.isDestroyed() {
+		return 0
+	}
+	return uint(C.cairo_pattern_get_reference_count(p.pattern_n))
+}
+
 func (p *Pattern) AddColorStopRGB(offset, red, green, blue float64) {
+	if p.isDestroyed() {
+		return
+	}
 	C.cairo_pattern_add_color_stop_rgb(p.pattern_n, C.double(offset), C.double(red), C.double(green), C.double(blue))
 }
 
 func (p *Pattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) {
+	if p.isDestroyed() {
+		return
+	}
 	C.cairo_pattern_add_color_stop_rgba(p.pattern_n, C.double(offset), C.double(red), C.double(green), C.double(blue), C.double(alpha))
 }
 
 func (p *Pattern) SetExtend(extend Extend) {
+	if p.isDestroyed() {
+		return
+	}
 	C.cairo_pattern_set_extend(p.pattern_n, C.cairo_extend_t(extend))
 }
 
 func (p *Pattern) SetMatrix(m *Matrix) {
+	if p.isDestroyed() {
+		return
+	}
 	C.cairo_pattern_set_matrix(p.pattern_n, m.matrixNative)
 }
@@ -0,0 +1,164 @@
+//go:build cairo_pdf
+// +build cairo_pdf
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-pdf
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-pdf.h>
+//
+// static int pdf_set_custom_metadata(cairo_surface_t *surface, const char *name, const char *value)
+// {
+// #if CAIRO_VERSION >= CAIRO_VERSION_ENCODE(1, 18, 0)
+//     cairo_pdf_surface_set_custom_metadata(surface, name, value);
+//     return 1;
+// #else
+//     return 0;
+// #endif
+// }
+import "C"
+
+type PDFVersion int // cairo_pdf_version_t
+
+const (
+	PDF_VERSION_1_4 PDFVersion = C.CAIRO_PDF_VERSION_1_4
+	PDF_VERSION_1_5 PDFVersion = C.CAIRO_PDF_VERSION_1_5
+)
+
+func (v PDFVersion) String() string {
+	return C.GoString(C.cairo_pdf_version_to_string(C.cairo_pdf_version_t(v)))
+}
+
+type PDFOutlineFlags int // cairo_pdf_outline_flags_t
+
+const (
+	PDF_OUTLINE_FLAG_OPEN   PDFOutlineFlags = C.CAIRO_PDF_OUTLINE_FLAG_OPEN
+	PDF_OUTLINE_FLAG_BOLD   PDFOutlineFlags = C.CAIRO_PDF_OUTLINE_FLAG_BOLD
+	PDF_OUTLINE_FLAG_ITALIC PDFOutlineFlags = C.CAIRO_PDF_OUTLINE_FLAG_ITALIC
+)
+
+// PDF_OUTLINE_ROOT is the parent id of the top level outline items.
+const PDF_OUTLINE_ROOT = C.CAIRO_PDF_OUTLINE_ROOT
+
+type PDFMetadata int // cairo_pdf_metadata_t
+
+const (
+	PDF_METADATA_TITLE       PDFMetadata = C.CAIRO_PDF_METADATA_TITLE
+	PDF_METADATA_AUTHOR      PDFMetadata = C.CAIRO_PDF_METADATA_AUTHOR
+	PDF_METADATA_SUBJECT     PDFMetadata = C.CAIRO_PDF_METADATA_SUBJECT
+	PDF_METADATA_KEYWORDS    PDFMetadata = C.CAIRO_PDF_METADATA_KEYWORDS
+	PDF_METADATA_CREATOR     PDFMetadata = C.CAIRO_PDF_METADATA_CREATOR
+	PDF_METADATA_CREATE_DATE PDFMetadata = C.CAIRO_PDF_METADATA_CREATE_DATE
+	PDF_METADATA_MOD_DATE    PDFMetadata = C.CAIRO_PDF_METADATA_MOD_DATE
+)
+
+type PDFSurface struct {
+	*Surface
+}
+
+// NewPDFSurface creates a PDF file with pages of the given size in points (1/72 inch).
+func NewPDFSurface(fileName string, widthPt, heightPt float64) (*PDFSurface, error) {
+
+	cstr := newCString(fileName)
+	defer freeCString(cstr)
+
+	surfaceNative := C.cairo_pdf_surface_create(cstr, C.double(widthPt), C.double(heightPt))
+
+	s, err := newSurface(surfaceNative)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PDFSurface{s}, nil
+}
+
+func (s *PDFSurface) RestrictToVersion(version PDFVersion) {
+	if s.isDestroyed() {
+		return
+	}
+	C.cairo_pdf_surface_restrict_to_version(s.surfaceNative, C.cairo_pdf_version_t(version))
+}
+
+// SetSize changes the size of the following pages.
+func (s *PDFSurface) SetSize(widthPt, heightPt float64) {
+	if s.isDestroyed() {
+		return
+	}
+	C.cairo_pdf_surface_set_size(s.surfaceNative, C.double(widthPt), C.double(heightPt))
+}
+
+// AddOutline adds an item to the document outline (bookmarks) and returns its id,
+// which can be used as parentID of the nested items.
+// linkAttributes is the target of the item in the format of LinkAttributes.
+func (s *PDFSurface) AddOutline(parentID int, name string, linkAttributes string, flags PDFOutlineFlags) int {
+
+	if s.isDestroyed() {
+		return 0
+	}
+
+	cstrName := newCString(name)
+	defer freeCString(cstrName)
+
+	cstrLinkAttributes := newCString(linkAttributes)
+	defer freeCString(cstrLinkAttributes)
+
+	return int(C.cairo_pdf_surface_add_outline(s.surfaceNative, C.int(parentID),
+		cstrName, cstrLinkAttributes, C.cairo_pdf_outline_flags_t(flags)))
+}
+
+func (s *PDFSurface) SetMetadata(metadata PDFMetadata, value string) {
+
+	if s.isDestroyed() {
+		return
+	}
+
+	cstr := newCString(value)
+	defer freeCString(cstr)
+
+	C.cairo_pdf_surface_set_metadata(s.surfaceNative, C.cairo_pdf_metadata_t(metadata), cstr)
+}
+
+// SetCustomMetadata sets a metadata entry of the document information dictionary.
+// It requires cairo 1.18 or later.
+func (s *PDFSurface) SetCustomMetadata(name, value string) error {
+
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	cstrName := newCString(name)
+	defer freeCString(cstrName)
+
+	cstrValue := newCString(value)
+	defer freeCString(cstrValue)
+
+	if C.pdf_set_custom_metadata(s.surfaceNative, cstrName, cstrValue) == 0 {
+		return newCairoError("PDFSurface.SetCustomMetadata(): not supported by cairo version")
+	}
+
+	return checkCairoStatus(C.cairo_surface_status(s.surfaceNative))
+}
+
+// SetPageLabel sets the label of the current page, shown by PDF viewers
+// instead of the page number.
+func (s *PDFSurface) SetPageLabel(label string) {
+
+	if s.isDestroyed() {
+		return
+	}
+
+	cstr := newCString(label)
+	defer freeCString(cstr)
+
+	C.cairo_pdf_surface_set_page_label(s.surfaceNative, cstr)
+}
+
+// SetThumbnailSize sets the size of the thumbnail images embedded for
+// the following pages. Thumbnails are disabled if the size is 0.
+func (s *PDFSurface) SetThumbnailSize(width, height int) {
+	if s.isDestroyed() {
+		return
+	}
+	C.cairo_pdf_surface_set_thumbnail_size(s.surfaceNative, C.int(width), C.int(height))
+}
@@ -0,0 +1,26 @@
+package cairo
+
+// #include <cairo.h>
+import "C"
+
+type RectangleInt struct {
+	X, Y          int
+	Width, Height int
+}
+
+func (r *RectangleInt) native() *C.cairo_rectangle_int_t {
+	if r == nil {
+		return nil
+	}
+	return &C.cairo_rectangle_int_t{
+		x:      C.int(r.X),
+		y:      C.int(r.Y),
+		width:  C.int(r.Width),
+		height: C.int(r.Height),
+	}
+}
+
+type Rectangle struct {
+	X, Y          float64
+	Width, Height float64
+}
@@ -0,0 +1,209 @@
+package cairo
+
+import "testing"
+
+type referenceCounter interface {
+	GetReferenceCount() uint
+}
+
+func checkReferenceCount(t *testing.T, name string, r referenceCounter, want uint) {
+	t.Helper()
+	if got := r.GetReferenceCount(); got != want {
+		t.Errorf("%s: reference count %d, want %d", name, got, want)
+	}
+}
+
+func newTestCanvas(t *testing.T) (*Surface, *Canvas) {
+	t.Helper()
+
+	s, err := NewSurface(FORMAT_ARGB32, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewCanvas(s)
+	if err != nil {
+		s.Destroy()
+		t.Fatal(err)
+	}
+
+	return s, c
+}
+
+func TestCanvasGetTarget(t *testing.T) {
+
+	s, c := newTestCanvas(t)
+	defer s.Destroy()
+
+	before := s.GetReferenceCount()
+
+	target := c.GetTarget()
+	if target == nil {
+		t.Fatal("GetTarget returned nil")
+	}
+	checkReferenceCount(t, "GetTarget", s, before+1)
+
+	target.Destroy()
+	checkReferenceCount(t, "GetTarget after Destroy", s, before)
+
+	c.Destroy()
+	checkReferenceCount(t, "canvas Destroy", s, before-1)
+
+	if err := s.GetData(make([]byte, s.GetDataLength())); err != nil {
+		t.Errorf("surface is unusable after destroying the target wrapper: %v", err)
+	}
+}
+
+func TestCanvasGetGroupTarget(t *testing.T) {
+
+	s, c := newTestCanvas(t)
+	defer s.Destroy()
+	defer c.Destroy()
+
+	c.PushGroup()
+
+	group := c.GetGroupTarget()
+	if group == nil {
+		t.Fatal("GetGroupTarget returned nil")
+	}
+	before := group.GetReferenceCount()
+
+	other := c.GetGroupTarget()
+	checkReferenceCount(t, "GetGroupTarget", group, before+1)
+
+	other.Destroy()
+	checkReferenceCount(t, "GetGroupTarget after Destroy", group, before)
+
+	group.Destroy()
+}
+
+func TestCanvasGetSource(t *testing.T) {
+
+	s, c := newTestCanvas(t)
+	defer s.Destroy()
+	defer c.Destroy()
+
+	p, err := NewPatternLinear(0, 0, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+
+	checkReferenceCount(t, "new pattern", p, 1)
+
+	c.SetSource(p)
+	checkReferenceCount(t, "SetSource", p, 2)
+
+	source := c.GetSource()
+	if source == nil {
+		t.Fatal("GetSource returned nil")
+	}
+	if source.Native() != p.Native() {
+		t.Error("GetSource returned another pattern")
+	}
+	checkReferenceCount(t, "GetSource", p, 3)
+
+	source.Destroy()
+	checkReferenceCount(t, "GetSource after Destroy", p, 2)
+
+	c.SetSourceRGB(0, 0, 0)
+	checkReferenceCount(t, "SetSourceRGB", p, 1)
+}
+
+func TestReference(t *testing.T) {
+
+	s, c := newTestCanvas(t)
+	defer s.Destroy()
+	defer c.Destroy()
+
+	p, err := NewPatternForSurface(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Destroy()
+
+	tests := []struct {
+		name      string
+		object    referenceCounter
+		reference func() interface{ Destroy() }
+	}{
+		{"Surface", s, func() interface{ Destroy() } { return s.Reference() }},
+		{"Canvas", c, func() interface{ Destroy() } { return c.Reference() }},
+		{"Pattern", p, func() interface{ Destroy() } { return p.Reference() }},
+	}
+
+	for _, test := range tests {
+
+		before := test.object.GetReferenceCount()
+
+		r := test.reference()
+		checkReferenceCount(t, test.name+" Reference", test.object, before+1)
+
+		r.Destroy()
+		checkReferenceCount(t, test.name+" Reference after Destroy", test.object, before)
+	}
+}
+
+func TestTeeSurfaceIndex(t *testing.T) {
+
+	primary, err := NewSurface(FORMAT_ARGB32, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer primary.Destroy()
+
+	tee, err := NewTeeSurface(primary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tee.Destroy()
+
+	before := primary.GetReferenceCount()
+
+	index := tee.Index(0)
+	if index == nil {
+		t.Fatal("Index(0) returned nil")
+	}
+	checkReferenceCount(t, "TeeSurface.Index", primary, before+1)
+
+	index.Destroy()
+	checkReferenceCount(t, "TeeSurface.Index after Destroy", primary, before)
+}
+
+func TestScaledFontGetters(t *testing.T) {
+
+	s, c := newTestCanvas(t)
+	defer s.Destroy()
+	defer c.Destroy()
+
+	sf := c.GetScaledFont()
+	if sf == nil {
+		t.Fatal("GetScaledFont returned nil")
+	}
+	defer sf.Destroy()
+
+	before := sf.GetReferenceCount()
+
+	other := c.GetScaledFont()
+	checkReferenceCount(t, "Canvas.GetScaledFont", sf, before+1)
+	other.Destroy()
+	checkReferenceCount(t, "Canvas.GetScaledFont after Destroy", sf, before)
+
+	r := sf.Reference()
+	checkReferenceCount(t, "ScaledFont.Reference", sf, before+1)
+	r.Destroy()
+	checkReferenceCount(t, "ScaledFont.Reference after Destroy", sf, before)
+
+	f := sf.GetFontFace()
+	if f == nil {
+		t.Fatal("GetFontFace returned nil")
+	}
+	defer f.Destroy()
+
+	before = f.GetReferenceCount()
+
+	other2 := sf.GetFontFace()
+	checkReferenceCount(t, "ScaledFont.GetFontFace", f, before+1)
+	other2.Destroy()
+	checkReferenceCount(t, "ScaledFont.GetFontFace after Destroy", f, before)
+}
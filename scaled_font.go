@@ -0,0 +1,212 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+type ScaledFont struct {
+	scaledFontNative *C.cairo_scaled_font_t
+}
+
+func newScaledFont(scaledFontNative *C.cairo_scaled_font_t) (*ScaledFont, error) {
+
+	err := checkCairoStatus(C.cairo_scaled_font_status(scaledFontNative))
+	if err != nil {
+		return nil, err
+	}
+
+	sf := &ScaledFont{scaledFontNative}
+	debugTrack("ScaledFont", unsafe.Pointer(sf))
+
+	runtime.SetFinalizer(sf, (*ScaledFont).destroy)
+
+	return sf, nil
+}
+
+func (sf *ScaledFont) destroy() {
+	C.cairo_scaled_font_destroy(sf.scaledFontNative)
+	debugUntrack(unsafe.Pointer(sf), false)
+}
+
+func (sf *ScaledFont) Destroy() {
+
+	if sf.scaledFontNative == nil {
+		sf.debugCheck()
+		return
+	}
+	debugUntrack(unsafe.Pointer(sf), true)
+	sf.destroy()
+	sf.scaledFontNative = nil
+
+	runtime.SetFinalizer(sf, nil)
+}
+
+func (sf *ScaledFont) debugCheck() {
+	if debugEnabled && sf.scaledFontNative == nil {
+		debugPanicDestroyed("ScaledFont", unsafe.Pointer(sf))
+	}
+}
+
+func (sf *ScaledFont) isDestroyed() bool {
+	sf.debugCheck()
+	return sf.scaledFontNative == nil
+}
+
+// NewScaledFont creates a font face scaled by fontMatrix (font space to user space)
+// and ctm (user space to device space).
+func NewScaledFont(f *FontFace, fontMatrix, ctm *Matrix, options *FontOptions) (*ScaledFont, error) {
+
+	if f.isDestroyed() || options.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	scaledFontNative := C.cairo_scaled_font_create(f.fontFaceNative,
+		fontMatrix.matrixNative, ctm.matrixNative, options.fontOptionsNative)
+
+	status := Status(C.cairo_scaled_font_status(scaledFontNative))
+	if status == STATUS_USER_FONT_ERROR {
+		return nil, userFontStatusError(f.fontFaceNative, nil, status)
+	}
+
+	return newScaledFont(scaledFontNative)
+}
+
+func NewScaledFontNative(ptr uintptr) (*ScaledFont, error) {
+
+	scaledFontNative := (*C.cairo_scaled_font_t)(unsafe.Pointer(ptr))
+	reference := C.cairo_scaled_font_reference(scaledFontNative)
+
+	return newScaledFont(reference)
+}
+
+func (sf *ScaledFont) Native() uintptr {
+	return uintptr(unsafe.Pointer(sf.scaledFontNative))
+}
+
+func (sf *ScaledFont) Reference() *ScaledFont {
+
+	if sf.isDestroyed() {
+		return nil
+	}
+
+	reference := C.cairo_scaled_font_reference(sf.scaledFontNative)
+
+	sfr, err := newScaledFont(reference)
+	if err != nil {
+		C.cairo_scaled_font_destroy(reference)
+		return nil
+	}
+
+	return sfr
+}
+
+func (sf *ScaledFont) GetReferenceCount() uint {
+	if sf.isDestroyed() {
+		return 0
+	}
+	return uint(C.cairo_scaled_font_get_reference_count(sf.scaledFontNative))
+}
+
+func (sf *ScaledFont) Status() Status {
+	if sf.isDestroyed() {
+		return STATUS_NULL_POINTER
+	}
+	return Status(C.cairo_scaled_font_status(sf.scaledFontNative))
+}
+
+func (sf *ScaledFont) GetType() FontType {
+	if sf.isDestroyed() {
+		return 0
+	}
+	return FontType(C.cairo_scaled_font_get_type(sf.scaledFontNative))
+}
+
+func (sf *ScaledFont) Extents(fontExtents *FontExtents) {
+
+	if sf.isDestroyed() {
+		return
+	}
+
+	if fontExtents == nil {
+		return
+	}
+
+	var extents C.cairo_font_extents_t
+
+	C.cairo_scaled_font_extents(sf.scaledFontNative, &extents)
+
+	fontExtents.setNative(&extents)
+}
+
+func (sf *ScaledFont) TextExtents(text string, textExtents *TextExtents) {
+
+	if sf.isDestroyed() {
+		return
+	}
+
+	if textExtents == nil {
+		return
+	}
+
+	cstr := newCString(text)
+	defer freeCString(cstr)
+
+	var extents C.cairo_text_extents_t
+
+	C.cairo_scaled_font_text_extents(sf.scaledFontNative, cstr, &extents)
+
+	textExtents.setNative(&extents)
+}
+
+func (sf *ScaledFont) GetFontFace() *FontFace {
+
+	if sf.isDestroyed() {
+		return nil
+	}
+
+	fontFaceNative := C.cairo_scaled_font_get_font_face(sf.scaledFontNative)
+	reference := C.cairo_font_face_reference(fontFaceNative)
+
+	f, err := newFontFace(reference)
+	if err != nil {
+		C.cairo_font_face_destroy(reference)
+		return nil
+	}
+
+	return f
+}
+
+func (sf *ScaledFont) GetFontMatrix(matrix *Matrix) {
+	if sf.isDestroyed() {
+		return
+	}
+	C.cairo_scaled_font_get_font_matrix(sf.scaledFontNative, matrix.matrixNative)
+}
+
+func (sf *ScaledFont) GetCTM(matrix *Matrix) {
+	if sf.isDestroyed() {
+		return
+	}
+	C.cairo_scaled_font_get_ctm(sf.scaledFontNative, matrix.matrixNative)
+}
+
+func (sf *ScaledFont) GetScaleMatrix(matrix *Matrix) {
+	if sf.isDestroyed() {
+		return
+	}
+	C.cairo_scaled_font_get_scale_matrix(sf.scaledFontNative, matrix.matrixNative)
+}
+
+func (sf *ScaledFont) GetFontOptions(options *FontOptions) {
+	if sf.isDestroyed() || options.isDestroyed() {
+		return
+	}
+	C.cairo_scaled_font_get_font_options(sf.scaledFontNative, options.fontOptionsNative)
+}
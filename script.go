@@ -0,0 +1,150 @@
+//go:build cairo_script
+// +build cairo_script
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-script
+// #include <stdint.h>
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-script.h>
+// #include "handle.h"
+// #include "stream.h"
+//
+// static cairo_user_data_key_t script_stream_key;
+//
+// static cairo_device_t *script_create_for_stream(uintptr_t handle)
+// {
+//     cairo_device_t *device;
+//     cairo_status_t status;
+//
+//     device = cairo_script_create_for_stream(stream_write, (void *)handle);
+//     if (cairo_device_status(device) != CAIRO_STATUS_SUCCESS) {
+//         return device;
+//     }
+//
+//     status = cairo_device_set_user_data(device, &script_stream_key, (void *)handle, handle_release);
+//     if (status != CAIRO_STATUS_SUCCESS) {
+//         cairo_device_destroy(device);
+//         return NULL;
+//     }
+//
+//     return device;
+// }
+import "C"
+
+import "io"
+
+type ScriptMode int // cairo_script_mode_t
+
+const (
+	SCRIPT_MODE_ASCII  ScriptMode = C.CAIRO_SCRIPT_MODE_ASCII
+	SCRIPT_MODE_BINARY ScriptMode = C.CAIRO_SCRIPT_MODE_BINARY
+)
+
+// Script is a device that records the drawing operations of its surfaces
+// in the cairo-script format.
+type Script struct {
+	*Device
+	writer *streamWriter
+}
+
+func newScript(deviceNative *C.cairo_device_t, writer *streamWriter) (*Script, error) {
+
+	d, err := newDevice(deviceNative)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Script{d, writer}, nil
+}
+
+func NewScriptFile(fileName string) (*Script, error) {
+
+	cstr := newCString(fileName)
+	defer freeCString(cstr)
+
+	return newScript(C.cairo_script_create(cstr), nil)
+}
+
+// NewScript creates a script device writing to w.
+// Call Finish to flush the script before w is closed.
+func NewScript(w io.Writer) (*Script, error) {
+
+	writer := &streamWriter{w: w}
+	h := newHandle(writer)
+
+	deviceNative := C.script_create_for_stream(C.uintptr_t(h))
+	if deviceNative == nil {
+		releaseHandle(h)
+		return nil, checkStatus(STATUS_NO_MEMORY)
+	}
+
+	err := checkCairoStatus(C.cairo_device_status(deviceNative))
+	if err != nil {
+		releaseHandle(h)
+		return nil, err
+	}
+
+	return newScript(deviceNative, writer)
+}
+
+// Err returns the first error returned by the writer of the script.
+func (s *Script) Err() error {
+	if s.writer == nil {
+		return nil
+	}
+	return s.writer.Err()
+}
+
+func (s *Script) SetMode(mode ScriptMode) {
+	if s.isDestroyed() {
+		return
+	}
+	C.cairo_script_set_mode(s.deviceNative, C.cairo_script_mode_t(mode))
+}
+
+func (s *Script) GetMode() ScriptMode {
+	if s.isDestroyed() {
+		return 0
+	}
+	return ScriptMode(C.cairo_script_get_mode(s.deviceNative))
+}
+
+func (s *Script) WriteComment(comment string) {
+
+	if s.isDestroyed() {
+		return
+	}
+
+	cstr := newCString(comment)
+	defer freeCString(cstr)
+
+	C.cairo_script_write_comment(s.deviceNative, cstr, C.int(len(comment)))
+}
+
+// NewSurface creates a surface which drawing operations are recorded in the script.
+func (s *Script) NewSurface(content Content, width, height float64) (*Surface, error) {
+
+	if s.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	surfaceNative := C.cairo_script_surface_create(s.deviceNative,
+		C.cairo_content_t(content), C.double(width), C.double(height))
+
+	return newSurface(surfaceNative)
+}
+
+// NewProxySurface creates a surface which draws to target and records
+// the drawing operations in the script.
+func (s *Script) NewProxySurface(target *Surface) (*Surface, error) {
+
+	if s.isDestroyed() || target.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	surfaceNative := C.cairo_script_surface_create_for_target(s.deviceNative, target.surfaceNative)
+
+	return newSurface(surfaceNative)
+}
@@ -0,0 +1,51 @@
+//go:build cairo_script
+// +build cairo_script
+
+package cairo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSurfaceGetDevice(t *testing.T) {
+
+	var buf bytes.Buffer
+
+	script, err := NewScript(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer script.Destroy()
+
+	s, err := script.NewSurface(CONTENT_COLOR_ALPHA, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Destroy()
+
+	before := script.GetReferenceCount()
+
+	d := s.GetDevice()
+	if d == nil {
+		t.Fatal("GetDevice returned nil")
+	}
+	checkReferenceCount(t, "Surface.GetDevice", script, before+1)
+
+	r := d.Reference()
+	checkReferenceCount(t, "Device.Reference", script, before+2)
+
+	r.Destroy()
+	d.Destroy()
+	checkReferenceCount(t, "Surface.GetDevice after Destroy", script, before)
+
+	image, err := NewSurface(FORMAT_ARGB32, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer image.Destroy()
+
+	if d := image.GetDevice(); d != nil {
+		t.Error("GetDevice of an image surface is not nil")
+	}
+}
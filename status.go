@@ -94,9 +94,20 @@ func (s Status) String() string {
 }
 
 func checkCairoStatus(nativeStatus C.cairo_status_t) error {
-	s := Status(nativeStatus)
+	return checkStatus(Status(nativeStatus))
+}
+
+// StatusError is the error of a status other than STATUS_SUCCESS.
+// It can be matched by errors.Is(err, StatusError(STATUS_INVALID_STRIDE)).
+type StatusError Status
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("cairo: %s", Status(e))
+}
+
+func checkStatus(s Status) error {
 	if s == STATUS_SUCCESS {
 		return nil
 	}
-	return newCairoError(s.String())
+	return StatusError(s)
 }
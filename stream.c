@@ -0,0 +1,9 @@
+#include <stdint.h>
+#include <cairo.h>
+#include "stream.h"
+#include "_cgo_export.h"
+
+cairo_status_t stream_write(void *closure, const unsigned char *data, unsigned int length)
+{
+	return goStreamWrite((uintptr_t)closure, (unsigned char *)data, length);
+}
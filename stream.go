@@ -0,0 +1,49 @@
+package cairo
+
+// #include <stdint.h>
+// #include <cairo.h>
+import "C"
+
+import (
+	"io"
+	"sync"
+	"unsafe"
+)
+
+// streamWriter is the Go side of a cairo_write_func_t closure.
+// cairo may write from a finalizer, so the writer is locked.
+type streamWriter struct {
+	sync.Mutex
+	w   io.Writer
+	err error // the first write error
+}
+
+func (sw *streamWriter) Err() error {
+	sw.Lock()
+	defer sw.Unlock()
+
+	return sw.err
+}
+
+//export goStreamWrite
+func goStreamWrite(h C.uintptr_t, data *C.uchar, length C.uint) C.cairo_status_t {
+
+	sw, ok := handleValue(uintptr(h)).(*streamWriter)
+	if !ok {
+		return C.CAIRO_STATUS_WRITE_ERROR
+	}
+
+	sw.Lock()
+	defer sw.Unlock()
+
+	if sw.err != nil {
+		return C.CAIRO_STATUS_WRITE_ERROR
+	}
+
+	_, sw.err = sw.w.Write(C.GoBytes(unsafe.Pointer(data), C.int(length)))
+	if sw.err != nil {
+		return C.CAIRO_STATUS_WRITE_ERROR
+	}
+
+	return C.CAIRO_STATUS_SUCCESS
+}
@@ -0,0 +1,10 @@
+#ifndef GO_CAIRO_STREAM_H
+#define GO_CAIRO_STREAM_H
+
+#include <cairo.h>
+
+// stream_write is a cairo_write_func_t writing to the Go streamWriter
+// which handle is passed as the closure.
+cairo_status_t stream_write(void *closure, const unsigned char *data, unsigned int length);
+
+#endif
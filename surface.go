@@ -5,15 +5,24 @@ package cairo
 // #include <string.h>
 // #include <cairo.h>
 // #include <cairo-gobject.h>
+//
+// static cairo_user_data_key_t surface_data_key;
+//
+// static cairo_status_t surface_attach_data(cairo_surface_t *surface, void *data)
+// {
+//     return cairo_surface_set_user_data(surface, &surface_data_key, data, free);
+// }
 import "C"
 
 import (
+	"reflect"
 	"runtime"
 	"unsafe"
 )
 
 type Surface struct {
 	surfaceNative *C.cairo_surface_t
+	mapTarget     *C.cairo_surface_t // surface of an image from MapToImage
 }
 
 func newSurface(surfaceNative *C.cairo_surface_t) (*Surface, error) {
@@ -23,7 +32,8 @@ func newSurface(surfaceNative *C.cairo_surface_t) (*Surface, error) {
 		return nil, err
 	}
 
-	s := &Surface{surfaceNative}
+	s := &Surface{surfaceNative: surfaceNative}
+	debugTrack("Surface", unsafe.Pointer(s))
 
 	runtime.SetFinalizer(s, (*Surface).destroy)
 
@@ -32,19 +42,39 @@ func newSurface(surfaceNative *C.cairo_surface_t) (*Surface, error) {
 
 func (s *Surface) destroy() {
 	C.cairo_surface_destroy(s.surfaceNative)
+	debugUntrack(unsafe.Pointer(s), false)
 }
 
 func (s *Surface) Destroy() {
 
 	if s.surfaceNative == nil {
+		s.debugCheck()
 		return
 	}
+	if s.mapTarget != nil {
+		if debugEnabled {
+			panic("cairo: Destroy of a mapped image, use UnmapImage")
+		}
+		return
+	}
+	debugUntrack(unsafe.Pointer(s), true)
 	s.destroy()
 	s.surfaceNative = nil
 
 	runtime.SetFinalizer(s, nil)
 }
 
+func (s *Surface) debugCheck() {
+	if debugEnabled && s.surfaceNative == nil {
+		debugPanicDestroyed("Surface", unsafe.Pointer(s))
+	}
+}
+
+func (s *Surface) isDestroyed() bool {
+	s.debugCheck()
+	return s.surfaceNative == nil
+}
+
 func NewSurface(format Format, width, height int) (*Surface, error) {
 
 	surfaceNative := C.cairo_image_surface_create(C.cairo_format_t(format), C.int(width), C.int(height))
@@ -70,7 +100,93 @@ func NewSurfaceNative(ptr uintptr) (*Surface, error) {
 	return newSurface(reference)
 }
 
+// NewSurfaceForData creates an image surface with the pixel buffer allocated
+// in C memory, which is freed when the surface is destroyed.
+// Unlike NewSurface the stride of the buffer is chosen by the caller.
+// The pixels are accessible through WithPixels.
+func NewSurfaceForData(format Format, width, height, stride int) (*Surface, error) {
+
+	err := checkStride(format, width, stride)
+	if err != nil {
+		return nil, err
+	}
+
+	size := stride * height
+	if size < 1 {
+		size = 1
+	}
+
+	dataNative := C.calloc(C.size_t(size), 1)
+	if dataNative == nil {
+		return nil, checkStatus(STATUS_NO_MEMORY)
+	}
+
+	surfaceNative := C.cairo_image_surface_create_for_data(
+		(*C.uchar)(dataNative),
+		C.cairo_format_t(format),
+		C.int(width),
+		C.int(height),
+		C.int(stride),
+	)
+
+	err = checkCairoStatus(C.cairo_surface_status(surfaceNative))
+	if err != nil {
+		C.cairo_surface_destroy(surfaceNative)
+		C.free(dataNative)
+		return nil, err
+	}
+
+	err = checkCairoStatus(C.surface_attach_data(surfaceNative, dataNative))
+	if err != nil {
+		C.cairo_surface_destroy(surfaceNative)
+		C.free(dataNative)
+		return nil, err
+	}
+
+	return newSurface(surfaceNative)
+}
+
+func checkStride(format Format, width, stride int) error {
+
+	if width < 0 {
+		return checkStatus(STATUS_INVALID_SIZE)
+	}
+
+	_, ok := formatNames[format]
+	if !ok || (format == FORMAT_INVALID) {
+		return checkStatus(STATUS_INVALID_FORMAT)
+	}
+
+	// The stride is -1 for a width too large.
+	minStride := format.StrideForWidth(width)
+	if minStride < 0 {
+		return checkStatus(STATUS_INVALID_SIZE)
+	}
+
+	// cairo requires the stride to be a multiple of 4 bytes.
+	if (stride < minStride) || (stride%4 != 0) {
+		return checkStatus(STATUS_INVALID_STRIDE)
+	}
+
+	return nil
+}
+
+// CreateSurfaceForData creates an image surface that draws to data.
+//
+// Deprecated: cairo keeps using data after the call returns, which breaks the
+// cgo pointer passing rules. Use NewSurfaceForData instead.
 func CreateSurfaceForData(data []byte, format Format, width, height, stride int) (*Surface, error) {
+
+	err := checkStride(format, width, stride)
+	if err != nil {
+		return nil, err
+	}
+
+	size := stride * height
+	if (size <= 0) || (len(data) == 0) || (len(data) < size) {
+		return nil, checkStatus(STATUS_INVALID_SIZE)
+	}
+
 	surfaceNative := C.cairo_image_surface_create_for_data(
 		(*C.uchar)(&data[0]),
 		C.cairo_format_t(format),
@@ -81,25 +197,107 @@ func CreateSurfaceForData(data []byte, format Format, width, height, stride int)
 	return newSurface(surfaceNative)
 }
 
+func (s *Surface) CreateSimilar(content Content, width, height int) (*Surface, error) {
+
+	if s.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	surfaceNative := C.cairo_surface_create_similar(s.surfaceNative,
+		C.cairo_content_t(content), C.int(width), C.int(height))
+
+	return newSurface(surfaceNative)
+}
+
+func (s *Surface) CreateSimilarImage(format Format, width, height int) (*Surface, error) {
+
+	if s.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	surfaceNative := C.cairo_surface_create_similar_image(s.surfaceNative,
+		C.cairo_format_t(format), C.int(width), C.int(height))
+
+	return newSurface(surfaceNative)
+}
+
+// CreateForRectangle creates a surface that is a view into the given area of s.
+// Drawing to and reading from the new surface goes directly to s, no pixels are copied.
+func (s *Surface) CreateForRectangle(x, y, width, height float64) (*Surface, error) {
+
+	if s.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	surfaceNative := C.cairo_surface_create_for_rectangle(s.surfaceNative,
+		C.double(x), C.double(y), C.double(width), C.double(height))
+
+	return newSurface(surfaceNative)
+}
+
 func (s *Surface) Native() uintptr {
 	return uintptr(unsafe.Pointer(s.surfaceNative))
 }
 
 func (s *Surface) Reference() *Surface {
 
+	if s.isDestroyed() {
+		return nil
+	}
+
 	reference := C.cairo_surface_reference(s.surfaceNative)
 
-	sr, _ := newSurface(reference)
+	sr, err := newSurface(reference)
+	if err != nil {
+		C.cairo_surface_destroy(reference)
+		return nil
+	}
 
 	return sr
 }
 
+// GetDevice returns the device of the surface or nil if the surface has no device.
+func (s *Surface) GetDevice() *Device {
+
+	if s.isDestroyed() {
+		return nil
+	}
+
+	deviceNative := C.cairo_surface_get_device(s.surfaceNative)
+	if deviceNative == nil {
+		return nil
+	}
+	reference := C.cairo_device_reference(deviceNative)
+
+	d, err := newDevice(reference)
+	if err != nil {
+		C.cairo_device_destroy(reference)
+		return nil
+	}
+
+	return d
+}
+
+func (s *Surface) GetReferenceCount() uint {
+	if s.isDestroyed() {
+		return 0
+	}
+	return uint(C.cairo_surface_get_reference_count(s.surfaceNative))
+}
+
 func (s *Surface) Finish() {
+	if s.isDestroyed() {
+		return
+	}
 	C.cairo_surface_finish(s.surfaceNative)
 }
 
 func (s *Surface) WriteToPNG(fileName string) error {
 
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+
 	cstr := newCString(fileName)
 	defer freeCString(cstr)
 
@@ -107,29 +305,160 @@ func (s *Surface) WriteToPNG(fileName string) error {
 }
 
 func (s *Surface) GetFormat() Format {
+	if s.isDestroyed() {
+		return 0
+	}
 	return Format(C.cairo_image_surface_get_format(s.surfaceNative))
 }
 
 func (s *Surface) GetWidth() int {
+	if s.isDestroyed() {
+		return 0
+	}
 	return int(C.cairo_image_surface_get_width(s.surfaceNative))
 }
 
 func (s *Surface) GetHeight() int {
+	if s.isDestroyed() {
+		return 0
+	}
 	return int(C.cairo_image_surface_get_height(s.surfaceNative))
 }
 
 func (s *Surface) GetStride() int {
+	if s.isDestroyed() {
+		return 0
+	}
 	return int(C.cairo_image_surface_get_stride(s.surfaceNative))
 }
 
+// GetFontOptions copies the default font options of the surface to options.
+func (s *Surface) GetFontOptions(options *FontOptions) {
+	if s.isDestroyed() || options.isDestroyed() {
+		return
+	}
+	C.cairo_surface_get_font_options(s.surfaceNative, options.fontOptionsNative)
+}
+
 func (s *Surface) Flush() {
+	if s.isDestroyed() {
+		return
+	}
 	C.cairo_surface_flush(s.surfaceNative)
 }
 
 func (s *Surface) MarkDirty() {
+	if s.isDestroyed() {
+		return
+	}
 	C.cairo_surface_mark_dirty(s.surfaceNative)
 }
 
+func (s *Surface) MarkDirtyRectangle(x, y, width, height int) {
+	if s.isDestroyed() {
+		return
+	}
+	C.cairo_surface_mark_dirty_rectangle(s.surfaceNative,
+		C.int(x), C.int(y), C.int(width), C.int(height))
+}
+
+// MapToImage returns an image surface that provides direct access to the pixels
+// of the extents area of s (the whole surface if extents is nil).
+// The returned surface must be released by UnmapImage; Destroy doesn't release it.
+func (s *Surface) MapToImage(extents *RectangleInt) (*Surface, error) {
+
+	if s.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	imageNative := C.cairo_surface_map_to_image(s.surfaceNative, extents.native())
+
+	err := checkCairoStatus(C.cairo_surface_status(imageNative))
+	if err != nil {
+		C.cairo_surface_unmap_image(s.surfaceNative, imageNative)
+		return nil, err
+	}
+
+	// The image is released by UnmapImage, so it has no finalizer.
+	image := &Surface{
+		surfaceNative: imageNative,
+		mapTarget:     s.surfaceNative,
+	}
+	debugTrack("Surface", unsafe.Pointer(image))
+
+	return image, nil
+}
+
+// UnmapImage uploads the content of image obtained from MapToImage back to s
+// and releases the image.
+func (s *Surface) UnmapImage(image *Surface) {
+
+	if s.isDestroyed() {
+		return
+	}
+
+	if image.isDestroyed() {
+		return
+	}
+	if image.mapTarget != s.surfaceNative {
+		if debugEnabled {
+			panic("cairo: UnmapImage of an image not mapped from the surface")
+		}
+		return
+	}
+	debugUntrack(unsafe.Pointer(image), true)
+	C.cairo_surface_unmap_image(s.surfaceNative, image.surfaceNative)
+	image.surfaceNative = nil
+}
+
+// WithPixels calls f with the pixel data of the image surface without copying.
+// The surface is flushed before f is called and marked dirty after f returns,
+// so that cairo drops the cached copies of the surface; use WithPixelsReadOnly
+// if f doesn't modify the pixels.
+// The slice refers to memory owned by the surface and must not be used
+// after f returns.
+func (s *Surface) WithPixels(f func(pixels []byte)) error {
+	return s.withPixels(f, true)
+}
+
+// WithPixelsReadOnly is WithPixels for f that doesn't modify the pixels.
+func (s *Surface) WithPixelsReadOnly(f func(pixels []byte)) error {
+	return s.withPixels(f, false)
+}
+
+func (s *Surface) withPixels(f func(pixels []byte), markDirty bool) error {
+
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	s.Flush()
+
+	dataPtr := unsafe.Pointer(C.cairo_image_surface_get_data(s.surfaceNative))
+	if dataPtr == nil {
+		return checkStatus(STATUS_SURFACE_TYPE_MISMATCH)
+	}
+
+	dataLen := s.GetDataLength()
+
+	var pixels []byte
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&pixels))
+	header.Data = uintptr(dataPtr)
+	header.Len = dataLen
+	header.Cap = dataLen
+
+	f(pixels)
+
+	if markDirty {
+		s.MarkDirty()
+	}
+
+	// The finalizer of s frees the pixels.
+	runtime.KeepAlive(s)
+
+	return nil
+}
+
 func (s *Surface) GetDataLength() int {
 
 	stride := s.GetStride()
@@ -140,6 +469,10 @@ func (s *Surface) GetDataLength() int {
 
 func (s *Surface) GetData(data []byte) error {
 
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+
 	dataLen := s.GetDataLength()
 	if len(data) != dataLen {
 		return newCairoError("Surface.GetData(): invalid data size")
@@ -157,6 +490,10 @@ func (s *Surface) GetData(data []byte) error {
 
 func (s *Surface) SetData(data []byte) error {
 
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+
 	dataLen := s.GetDataLength()
 	if len(data) != dataLen {
 		return newCairoError("Surface.SetData(): invalid data size")
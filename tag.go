@@ -0,0 +1,180 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	TAG_DEST = "cairo.dest" // destination of internal links
+	TAG_LINK = "Link"
+)
+
+// Standard PDF structure types for tagged documents.
+const (
+	TAG_DOCUMENT    = "Document"
+	TAG_PART        = "Part"
+	TAG_ART         = "Art"
+	TAG_SECT        = "Sect"
+	TAG_DIV         = "Div"
+	TAG_BLOCK_QUOTE = "BlockQuote"
+	TAG_CAPTION     = "Caption"
+	TAG_TOC         = "TOC"
+	TAG_TOCI        = "TOCI"
+	TAG_INDEX       = "Index"
+	TAG_P           = "P"
+	TAG_H           = "H"
+	TAG_H1          = "H1"
+	TAG_H2          = "H2"
+	TAG_H3          = "H3"
+	TAG_H4          = "H4"
+	TAG_H5          = "H5"
+	TAG_H6          = "H6"
+	TAG_L           = "L"
+	TAG_LI          = "LI"
+	TAG_LBL         = "Lbl"
+	TAG_LBODY       = "LBody"
+	TAG_TABLE       = "Table"
+	TAG_TR          = "TR"
+	TAG_TH          = "TH"
+	TAG_TD          = "TD"
+	TAG_THEAD       = "THead"
+	TAG_TBODY       = "TBody"
+	TAG_TFOOT       = "TFoot"
+	TAG_SPAN        = "Span"
+	TAG_QUOTE       = "Quote"
+	TAG_NOTE        = "Note"
+	TAG_REFERENCE   = "Reference"
+	TAG_CODE        = "Code"
+	TAG_FIGURE      = "Figure"
+	TAG_FORMULA     = "Formula"
+)
+
+// TagBegin marks the beginning of the tagName structure.
+// The attributes string can be made with LinkAttributes or DestAttributes.
+func (c *Canvas) TagBegin(tagName, attributes string) {
+
+	if c.isDestroyed() {
+		return
+	}
+
+	cstrTagName := newCString(tagName)
+	defer freeCString(cstrTagName)
+
+	cstrAttributes := newCString(attributes)
+	defer freeCString(cstrAttributes)
+
+	C.cairo_tag_begin(c.cr, cstrTagName, cstrAttributes)
+}
+
+func (c *Canvas) TagEnd(tagName string) {
+
+	if c.isDestroyed() {
+		return
+	}
+
+	cstrTagName := newCString(tagName)
+	defer freeCString(cstrTagName)
+
+	C.cairo_tag_end(c.cr, cstrTagName)
+}
+
+// LinkAttributes are the attributes of a TAG_LINK tag.
+// Set URI for an external link, Dest for a link to a named destination,
+// or Page (starting from 1) and Pos for a link to a position on a page.
+// Without Rects the link area is the area of the content inside the tag.
+type LinkAttributes struct {
+	URI   string
+	Dest  string
+	Page  int
+	Pos   *PathPoint
+	File  string // PDF file for Dest or Page links to another document
+	Rects []Rectangle
+}
+
+func (a LinkAttributes) String() string {
+
+	var b tagAttributesBuilder
+
+	b.addString("uri", a.URI)
+	b.addString("dest", a.Dest)
+	b.addString("file", a.File)
+	if a.Page > 0 {
+		b.add("page", strconv.Itoa(a.Page))
+	}
+	if a.Pos != nil {
+		b.addFloats("pos", a.Pos.X, a.Pos.Y)
+	}
+	if len(a.Rects) > 0 {
+		vs := make([]float64, 0, 4*len(a.Rects))
+		for _, r := range a.Rects {
+			vs = append(vs, r.X, r.Y, r.Width, r.Height)
+		}
+		b.addFloats("rect", vs...)
+	}
+
+	return b.String()
+}
+
+// DestAttributes are the attributes of a TAG_DEST tag.
+// Without Pos the destination is the position of the content inside the tag.
+type DestAttributes struct {
+	Name     string
+	Pos      *PathPoint
+	Internal bool // the destination is not exported in the PDF name dictionary
+}
+
+func (a DestAttributes) String() string {
+
+	var b tagAttributesBuilder
+
+	b.addString("name", a.Name)
+	if a.Pos != nil {
+		b.addFloats("x", a.Pos.X)
+		b.addFloats("y", a.Pos.Y)
+	}
+	if a.Internal {
+		b.add("internal", "true")
+	}
+
+	return b.String()
+}
+
+type tagAttributesBuilder struct {
+	strings.Builder
+}
+
+func (b *tagAttributesBuilder) add(name, value string) {
+	if b.Len() > 0 {
+		b.WriteByte(' ')
+	}
+	b.WriteString(name)
+	b.WriteByte('=')
+	b.WriteString(value)
+}
+
+func (b *tagAttributesBuilder) addString(name, value string) {
+	if value == "" {
+		return
+	}
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	b.add(name, "'"+r.Replace(value)+"'")
+}
+
+func (b *tagAttributesBuilder) addFloats(name string, vs ...float64) {
+	ss := make([]string, len(vs))
+	for i, v := range vs {
+		ss[i] = strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	if len(ss) == 1 {
+		b.add(name, ss[0])
+		return
+	}
+	b.add(name, "["+strings.Join(ss, " ")+"]")
+}
@@ -0,0 +1,74 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-tee.h>
+import "C"
+
+// TeeSurface draws to several target surfaces at once.
+type TeeSurface struct {
+	*Surface
+}
+
+// NewTeeSurface creates a tee surface with the primary target, which is
+// also used for the operations that read from the surface.
+func NewTeeSurface(primary *Surface) (*TeeSurface, error) {
+
+	if primary.isDestroyed() {
+		return nil, ErrDestroyed
+	}
+
+	surfaceNative := C.cairo_tee_surface_create(primary.surfaceNative)
+
+	s, err := newSurface(surfaceNative)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TeeSurface{s}, nil
+}
+
+func (s *TeeSurface) Add(target *Surface) error {
+
+	if s.isDestroyed() || target.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	C.cairo_tee_surface_add(s.surfaceNative, target.surfaceNative)
+
+	return checkCairoStatus(C.cairo_surface_status(s.surfaceNative))
+}
+
+// Remove removes a target added by Add.
+// Removing a surface that is not a target puts the tee surface into an error state.
+func (s *TeeSurface) Remove(target *Surface) error {
+
+	if s.isDestroyed() || target.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	C.cairo_tee_surface_remove(s.surfaceNative, target.surfaceNative)
+
+	return checkCairoStatus(C.cairo_surface_status(s.surfaceNative))
+}
+
+// Index returns the target with the index, where the primary target has index 0,
+// or nil if there is no such target.
+func (s *TeeSurface) Index(index int) *Surface {
+
+	if s.isDestroyed() {
+		return nil
+	}
+
+	surfaceNative := C.cairo_tee_surface_index(s.surfaceNative, C.uint(index))
+	reference := C.cairo_surface_reference(surfaceNative)
+
+	sr, err := newSurface(reference)
+	if err != nil {
+		C.cairo_surface_destroy(reference)
+		return nil
+	}
+
+	return sr
+}
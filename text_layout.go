@@ -0,0 +1,270 @@
+package cairo
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+type TextAlign int
+
+const (
+	TEXT_ALIGN_LEFT TextAlign = iota
+	TEXT_ALIGN_CENTER
+	TEXT_ALIGN_RIGHT
+	TEXT_ALIGN_JUSTIFY
+)
+
+type TextWrap int
+
+const (
+	TEXT_WRAP_NONE TextWrap = iota // break lines only at '\n'
+	TEXT_WRAP_WORD                 // break lines between words
+	TEXT_WRAP_CHAR                 // break lines between any characters
+)
+
+// TextAnchor sets which vertical part of the text box is placed at the layout point.
+type TextAnchor int
+
+const (
+	TEXT_ANCHOR_TOP      TextAnchor = iota
+	TEXT_ANCHOR_MIDDLE              // the middle of the box
+	TEXT_ANCHOR_BASELINE            // the baseline of the first line
+	TEXT_ANCHOR_BOTTOM
+)
+
+// TextLayout arranges multi-line text using the current font of a Canvas.
+type TextLayout struct {
+	MaxWidth    float64 // width of the text box, lines are wrapped to fit it; 0 means no limit
+	Wrap        TextWrap
+	Align       TextAlign
+	Anchor      TextAnchor
+	LineSpacing float64 // distance between baselines in font heights; 0 means 1
+}
+
+// TextSpan is a piece of text with the origin of its baseline.
+type TextSpan struct {
+	Text string
+	X, Y float64
+}
+
+// TextBox is the result of a text layout.
+type TextBox struct {
+	X, Y          float64
+	Width, Height float64
+	Spans         []TextSpan
+}
+
+type textLine struct {
+	text  string
+	words []string
+	last  bool // the last line of a paragraph
+}
+
+// Layout arranges text in a box positioned at (x, y).
+// Horizontally (x, y) is the left edge, the center or the right edge of the box
+// for left (or justify), center and right alignment.
+// Vertically it is set by the layout anchor.
+func (l *TextLayout) Layout(c *Canvas, text string, x, y float64) *TextBox {
+
+	var fontExtents FontExtents
+	c.FontExtents(&fontExtents)
+
+	lineSpacing := l.LineSpacing
+	if lineSpacing == 0 {
+		lineSpacing = 1
+	}
+	lineHeight := fontExtents.Height * lineSpacing
+
+	lines := l.wrapLines(c, text)
+
+	widths := make([]float64, len(lines))
+	for i, line := range lines {
+		widths[i] = textWidth(c, line.text)
+	}
+
+	b := new(TextBox)
+
+	if l.MaxWidth > 0 {
+		b.Width = l.MaxWidth
+	} else {
+		for _, w := range widths {
+			if b.Width < w {
+				b.Width = w
+			}
+		}
+	}
+
+	if len(lines) > 0 {
+		b.Height = float64(len(lines)-1)*lineHeight + fontExtents.Ascent + fontExtents.Descent
+	}
+
+	switch l.Align {
+	case TEXT_ALIGN_CENTER:
+		b.X = x - b.Width/2
+	case TEXT_ALIGN_RIGHT:
+		b.X = x - b.Width
+	default:
+		b.X = x
+	}
+
+	switch l.Anchor {
+	case TEXT_ANCHOR_MIDDLE:
+		b.Y = y - b.Height/2
+	case TEXT_ANCHOR_BASELINE:
+		b.Y = y - fontExtents.Ascent
+	case TEXT_ANCHOR_BOTTOM:
+		b.Y = y - b.Height
+	default:
+		b.Y = y
+	}
+
+	for i, line := range lines {
+
+		baseline := b.Y + fontExtents.Ascent + float64(i)*lineHeight
+
+		switch l.Align {
+		case TEXT_ALIGN_CENTER:
+			b.addSpan(line.text, b.X+(b.Width-widths[i])/2, baseline)
+		case TEXT_ALIGN_RIGHT:
+			b.addSpan(line.text, b.X+b.Width-widths[i], baseline)
+		case TEXT_ALIGN_JUSTIFY:
+			if line.last || (len(line.words) < 2) {
+				b.addSpan(line.text, b.X, baseline)
+				break
+			}
+			wordWidths := make([]float64, len(line.words))
+			var sum float64
+			for j, word := range line.words {
+				wordWidths[j] = textWidth(c, word)
+				sum += wordWidths[j]
+			}
+			gap := (b.Width - sum) / float64(len(line.words)-1)
+			wordX := b.X
+			for j, word := range line.words {
+				b.addSpan(word, wordX, baseline)
+				wordX += wordWidths[j] + gap
+			}
+		default:
+			b.addSpan(line.text, b.X, baseline)
+		}
+	}
+
+	return b
+}
+
+func (b *TextBox) addSpan(text string, x, y float64) {
+	if text == "" {
+		return
+	}
+	b.Spans = append(b.Spans, TextSpan{Text: text, X: x, Y: y})
+}
+
+func (l *TextLayout) wrapLines(c *Canvas, text string) []textLine {
+
+	var lines []textLine
+
+	for _, paragraph := range strings.Split(text, "\n") {
+
+		var ss []string
+		if l.MaxWidth <= 0 {
+			ss = []string{paragraph}
+		} else {
+			switch l.Wrap {
+			case TEXT_WRAP_WORD:
+				ss = wrapWords(c, paragraph, l.MaxWidth)
+			case TEXT_WRAP_CHAR:
+				ss = wrapChars(c, paragraph, l.MaxWidth)
+			default:
+				ss = []string{paragraph}
+			}
+		}
+
+		for i, s := range ss {
+			lines = append(lines, textLine{
+				text:  s,
+				words: strings.Fields(s),
+				last:  (i == len(ss)-1),
+			})
+		}
+	}
+
+	return lines
+}
+
+func wrapWords(c *Canvas, paragraph string, maxWidth float64) []string {
+
+	words := strings.Fields(paragraph)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var (
+		ss   []string
+		line = words[0]
+	)
+	for _, word := range words[1:] {
+		candidate := line + " " + word
+		if textWidth(c, candidate) <= maxWidth {
+			line = candidate
+		} else {
+			ss = append(ss, line)
+			line = word
+		}
+	}
+	ss = append(ss, line)
+
+	return ss
+}
+
+// wrapChars measures every distinct rune once and sums the advances,
+// so a line is never measured as a whole.
+func wrapChars(c *Canvas, paragraph string, maxWidth float64) []string {
+
+	var (
+		ss     []string
+		start  int     // byte offset of the current line in paragraph
+		width  float64 // advance of the current line
+		widths = make(map[rune]float64)
+	)
+	for i, r := range paragraph {
+		w, ok := widths[r]
+		if !ok {
+			w = textWidth(c, string(r))
+			widths[r] = w
+		}
+		if (i > start) && (width+w > maxWidth) {
+			ss = append(ss, paragraph[start:i])
+			if r == ' ' {
+				start, width = i+utf8.RuneLen(r), 0
+				continue
+			}
+			start, width = i, 0
+		}
+		width += w
+	}
+	ss = append(ss, paragraph[start:])
+
+	return ss
+}
+
+func textWidth(c *Canvas, text string) float64 {
+	var extents TextExtents
+	c.TextExtents(text, &extents)
+	return extents.AdvanceX
+}
+
+// ShowTextBox draws the text of the box with the current font and source.
+func (c *Canvas) ShowTextBox(b *TextBox) {
+	for _, span := range b.Spans {
+		c.MoveTo(span.X, span.Y)
+		c.ShowText(span.Text)
+	}
+}
+
+// TextBoxPath adds the outlines of the text of the box to the current path.
+func (c *Canvas) TextBoxPath(b *TextBox) {
+	for _, span := range b.Spans {
+		c.MoveTo(span.X, span.Y)
+		c.TextPath(span.Text)
+	}
+}
@@ -0,0 +1,174 @@
+package cairo
+
+import "math"
+
+// number of line segments a curve is divided into by TextOnPath
+const curveSegments = 32
+
+type pathSegment struct {
+	a, b   PathPoint
+	start  float64 // distance from the path start to a
+	length float64
+}
+
+// flattenPath converts the path into line segments.
+// The path must be checked before.
+func flattenPath(p *Path) (segments []pathSegment, length float64) {
+
+	var current, subpathStart PathPoint
+
+	addLine := func(b PathPoint) {
+		d := math.Hypot(b.X-current.X, b.Y-current.Y)
+		if d > 0 {
+			segments = append(segments, pathSegment{
+				a:      current,
+				b:      b,
+				start:  length,
+				length: d,
+			})
+			length += d
+		}
+		current = b
+	}
+
+	for _, e := range p.Elements {
+		switch e.Type {
+		case PATH_MOVE_TO:
+			current = e.Points[0]
+			subpathStart = current
+		case PATH_LINE_TO:
+			addLine(e.Points[0])
+		case PATH_CURVE_TO:
+			p0 := current
+			for i := 1; i <= curveSegments; i++ {
+				t := float64(i) / curveSegments
+				addLine(bezierPoint(p0, e.Points[0], e.Points[1], e.Points[2], t))
+			}
+		case PATH_CLOSE_PATH:
+			addLine(subpathStart)
+		}
+	}
+
+	return segments, length
+}
+
+func bezierPoint(p0, p1, p2, p3 PathPoint, t float64) PathPoint {
+	var (
+		u = 1 - t
+
+		k0 = u * u * u
+		k1 = 3 * u * u * t
+		k2 = 3 * u * t * t
+		k3 = t * t * t
+	)
+	return PathPoint{
+		X: k0*p0.X + k1*p1.X + k2*p2.X + k3*p3.X,
+		Y: k0*p0.Y + k1*p1.Y + k2*p2.Y + k3*p3.Y,
+	}
+}
+
+// pointAt returns the point at the distance d along the segments
+// and the angle of the tangent at this point.
+func pointAt(segments []pathSegment, d float64) (PathPoint, float64) {
+
+	s := segments[len(segments)-1]
+	for _, segment := range segments {
+		if d < segment.start+segment.length {
+			s = segment
+			break
+		}
+	}
+
+	t := (d - s.start) / s.length
+	p := PathPoint{
+		X: s.a.X + (s.b.X-s.a.X)*t,
+		Y: s.a.Y + (s.b.Y-s.a.Y)*t,
+	}
+	angle := math.Atan2(s.b.Y-s.a.Y, s.b.X-s.a.X)
+
+	return p, angle
+}
+
+// TextOnPath draws text along the path with the current font and source,
+// each glyph rotated to follow the tangent of the path.
+// The text starts at the distance offset from the path start for left alignment,
+// is centered in the rest of the path for center alignment, ends offset before
+// the path end for right alignment, and is spread over the rest of the path for justify.
+// Glyphs that fall outside of the path are not drawn.
+func (c *Canvas) TextOnPath(text string, path *Path, offset float64, align TextAlign) error {
+
+	if c.isDestroyed() {
+		return ErrDestroyed
+	}
+
+	err := path.check()
+	if err != nil {
+		return err
+	}
+
+	segments, length := flattenPath(path)
+	if len(segments) == 0 {
+		return nil
+	}
+
+	sf := c.GetScaledFont()
+	if sf == nil {
+		return checkStatus(c.Status())
+	}
+	defer sf.Destroy()
+
+	glyphs, _, _, err := sf.TextToGlyphs(0, 0, text)
+	if err != nil {
+		return err
+	}
+	if len(glyphs) == 0 {
+		return nil
+	}
+
+	advances := make([]float64, len(glyphs))
+	for i := range glyphs {
+		if i+1 < len(glyphs) {
+			advances[i] = glyphs[i+1].X - glyphs[i].X
+			continue
+		}
+		var extents TextExtents
+		sf.GlyphExtents(glyphs[i:], &extents)
+		advances[i] = extents.AdvanceX
+	}
+
+	last := len(glyphs) - 1
+	width := glyphs[last].X + advances[last]
+
+	var start, spacing float64
+	switch align {
+	case TEXT_ALIGN_CENTER:
+		start = offset + (length-offset-width)/2
+	case TEXT_ALIGN_RIGHT:
+		start = length - offset - width
+	case TEXT_ALIGN_JUSTIFY:
+		start = offset
+		if (last > 0) && (length-offset > width) {
+			spacing = (length - offset - width) / float64(last)
+		}
+	default:
+		start = offset
+	}
+
+	for i, g := range glyphs {
+
+		middle := start + g.X + float64(i)*spacing + advances[i]/2
+		if (middle < 0) || (middle > length) {
+			continue
+		}
+
+		p, angle := pointAt(segments, middle)
+
+		c.Save()
+		c.Translate(p.X, p.Y)
+		c.Rotate(angle)
+		c.ShowGlyphs([]Glyph{{Index: g.Index, X: -advances[i] / 2, Y: g.Y}})
+		c.Restore()
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdint.h>
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include "handle.h"
+//
+// // The handle of the Go user data map of an object is stored under user_data_key
+// // and released by cairo when the object is destroyed.
+// static cairo_user_data_key_t user_data_key;
+//
+// static uintptr_t canvas_get_user_data(cairo_t *cr)
+// {
+//     return (uintptr_t)cairo_get_user_data(cr, &user_data_key);
+// }
+//
+// static cairo_status_t canvas_set_user_data(cairo_t *cr, uintptr_t handle)
+// {
+//     return cairo_set_user_data(cr, &user_data_key, (void *)handle, handle_release);
+// }
+//
+// static uintptr_t surface_get_user_data(cairo_surface_t *surface)
+// {
+//     return (uintptr_t)cairo_surface_get_user_data(surface, &user_data_key);
+// }
+//
+// static cairo_status_t surface_set_user_data(cairo_surface_t *surface, uintptr_t handle)
+// {
+//     return cairo_surface_set_user_data(surface, &user_data_key, (void *)handle, handle_release);
+// }
+//
+// static uintptr_t pattern_get_user_data(cairo_pattern_t *pattern)
+// {
+//     return (uintptr_t)cairo_pattern_get_user_data(pattern, &user_data_key);
+// }
+//
+// static cairo_status_t pattern_set_user_data(cairo_pattern_t *pattern, uintptr_t handle)
+// {
+//     return cairo_pattern_set_user_data(pattern, &user_data_key, (void *)handle, handle_release);
+// }
+//
+// static uintptr_t font_face_get_user_data(cairo_font_face_t *font_face)
+// {
+//     return (uintptr_t)cairo_font_face_get_user_data(font_face, &user_data_key);
+// }
+//
+// static cairo_status_t font_face_set_user_data(cairo_font_face_t *font_face, uintptr_t handle)
+// {
+//     return cairo_font_face_set_user_data(font_face, &user_data_key, (void *)handle, handle_release);
+// }
+//
+// static uintptr_t scaled_font_get_user_data(cairo_scaled_font_t *scaled_font)
+// {
+//     return (uintptr_t)cairo_scaled_font_get_user_data(scaled_font, &user_data_key);
+// }
+//
+// static cairo_status_t scaled_font_set_user_data(cairo_scaled_font_t *scaled_font, uintptr_t handle)
+// {
+//     return cairo_scaled_font_set_user_data(scaled_font, &user_data_key, (void *)handle, handle_release);
+// }
+//
+// static uintptr_t device_get_user_data(cairo_device_t *device)
+// {
+//     return (uintptr_t)cairo_device_get_user_data(device, &user_data_key);
+// }
+//
+// static cairo_status_t device_set_user_data(cairo_device_t *device, uintptr_t handle)
+// {
+//     return cairo_device_set_user_data(device, &user_data_key, (void *)handle, handle_release);
+// }
+import "C"
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// userData holds the values attached to a cairo object. It is shared by
+// all wrappers of the object and released together with the object.
+type userData struct {
+	sync.Mutex
+	values map[interface{}]interface{}
+}
+
+// userDataLocks serialize the attachment of the user data maps. The lock of
+// an object is chosen by its address, so unrelated objects rarely share one.
+var userDataLocks [64]sync.Mutex
+
+func userDataLock(object unsafe.Pointer) *sync.Mutex {
+	return &userDataLocks[(uintptr(object)>>4)%uintptr(len(userDataLocks))]
+}
+
+func checkUserDataKey(key interface{}) error {
+	if (key == nil) || !reflect.TypeOf(key).Comparable() {
+		return newCairoError("user data key is not comparable")
+	}
+	return nil
+}
+
+func getUserData(h C.uintptr_t, key interface{}) interface{} {
+
+	if checkUserDataKey(key) != nil {
+		return nil
+	}
+
+	ud, ok := handleValue(uintptr(h)).(*userData)
+	if !ok {
+		return nil
+	}
+
+	ud.Lock()
+	defer ud.Unlock()
+
+	return ud.values[key]
+}
+
+// setUserData looks up the handle of the user data map of object by get and
+// attaches a new one by attach if there is none. The lookup and the attachment
+// are done under the lock of the object, so that concurrent calls don't attach
+// two maps and lose the values of the first one.
+func setUserData(object unsafe.Pointer, get func() C.uintptr_t, attach func(h C.uintptr_t) C.cairo_status_t,
+	key, value interface{}) error {
+
+	err := checkUserDataKey(key)
+	if err != nil {
+		return err
+	}
+
+	lock := userDataLock(object)
+	lock.Lock()
+
+	h := uintptr(get())
+	if h == 0 {
+		if value == nil {
+			lock.Unlock()
+			return nil
+		}
+		h = newHandle(&userData{
+			values: make(map[interface{}]interface{}),
+		})
+		err = checkCairoStatus(attach(C.uintptr_t(h)))
+		if err != nil {
+			releaseHandle(h)
+			lock.Unlock()
+			return err
+		}
+	}
+
+	lock.Unlock()
+
+	ud, ok := handleValue(h).(*userData)
+	if !ok {
+		return checkStatus(STATUS_NULL_POINTER)
+	}
+
+	ud.Lock()
+	defer ud.Unlock()
+
+	if value == nil {
+		delete(ud.values, key)
+	} else {
+		ud.values[key] = value
+	}
+
+	return nil
+}
+
+// SetUserData attaches value to the cairo context under key, which must be comparable.
+// The value is kept until it is replaced, removed by a nil value, or the context is destroyed.
+// To avoid collisions, keys should be of an unexported type, as for context.Context values.
+// The values are kept alive by the package, so a value that refers to the context
+// keeps it from being finalized; remove such a value or call Destroy.
+func (c *Canvas) SetUserData(key, value interface{}) error {
+	if c.isDestroyed() {
+		return ErrDestroyed
+	}
+	return setUserData(unsafe.Pointer(c.cr), func() C.uintptr_t {
+		return C.canvas_get_user_data(c.cr)
+	}, func(h C.uintptr_t) C.cairo_status_t {
+		return C.canvas_set_user_data(c.cr, h)
+	}, key, value)
+}
+
+// GetUserData returns the value attached under key or nil.
+func (c *Canvas) GetUserData(key interface{}) interface{} {
+	if c.isDestroyed() {
+		return nil
+	}
+	return getUserData(C.canvas_get_user_data(c.cr), key)
+}
+
+func (s *Surface) SetUserData(key, value interface{}) error {
+	if s.isDestroyed() {
+		return ErrDestroyed
+	}
+	return setUserData(unsafe.Pointer(s.surfaceNative), func() C.uintptr_t {
+		return C.surface_get_user_data(s.surfaceNative)
+	}, func(h C.uintptr_t) C.cairo_status_t {
+		return C.surface_set_user_data(s.surfaceNative, h)
+	}, key, value)
+}
+
+func (s *Surface) GetUserData(key interface{}) interface{} {
+	if s.isDestroyed() {
+		return nil
+	}
+	return getUserData(C.surface_get_user_data(s.surfaceNative), key)
+}
+
+func (p *Pattern) SetUserData(key, value interface{}) error {
+	if p.isDestroyed() {
+		return ErrDestroyed
+	}
+	return setUserData(unsafe.Pointer(p.pattern_n), func() C.uintptr_t {
+		return C.pattern_get_user_data(p.pattern_n)
+	}, func(h C.uintptr_t) C.cairo_status_t {
+		return C.pattern_set_user_data(p.pattern_n, h)
+	}, key, value)
+}
+
+func (p *Pattern) GetUserData(key interface{}) interface{} {
+	if p.isDestroyed() {
+		return nil
+	}
+	return getUserData(C.pattern_get_user_data(p.pattern_n), key)
+}
+
+func (f *FontFace) SetUserData(key, value interface{}) error {
+	if f.isDestroyed() {
+		return ErrDestroyed
+	}
+	return setUserData(unsafe.Pointer(f.fontFaceNative), func() C.uintptr_t {
+		return C.font_face_get_user_data(f.fontFaceNative)
+	}, func(h C.uintptr_t) C.cairo_status_t {
+		return C.font_face_set_user_data(f.fontFaceNative, h)
+	}, key, value)
+}
+
+func (f *FontFace) GetUserData(key interface{}) interface{} {
+	if f.isDestroyed() {
+		return nil
+	}
+	return getUserData(C.font_face_get_user_data(f.fontFaceNative), key)
+}
+
+func (sf *ScaledFont) SetUserData(key, value interface{}) error {
+	if sf.isDestroyed() {
+		return ErrDestroyed
+	}
+	return setUserData(unsafe.Pointer(sf.scaledFontNative), func() C.uintptr_t {
+		return C.scaled_font_get_user_data(sf.scaledFontNative)
+	}, func(h C.uintptr_t) C.cairo_status_t {
+		return C.scaled_font_set_user_data(sf.scaledFontNative, h)
+	}, key, value)
+}
+
+func (sf *ScaledFont) GetUserData(key interface{}) interface{} {
+	if sf.isDestroyed() {
+		return nil
+	}
+	return getUserData(C.scaled_font_get_user_data(sf.scaledFontNative), key)
+}
+
+func (d *Device) SetUserData(key, value interface{}) error {
+	if d.isDestroyed() {
+		return ErrDestroyed
+	}
+	return setUserData(unsafe.Pointer(d.deviceNative), func() C.uintptr_t {
+		return C.device_get_user_data(d.deviceNative)
+	}, func(h C.uintptr_t) C.cairo_status_t {
+		return C.device_set_user_data(d.deviceNative, h)
+	}, key, value)
+}
+
+func (d *Device) GetUserData(key interface{}) interface{} {
+	if d.isDestroyed() {
+		return nil
+	}
+	return getUserData(C.device_get_user_data(d.deviceNative), key)
+}
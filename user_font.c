@@ -0,0 +1,69 @@
+#include <stdint.h>
+#include <cairo.h>
+#include "handle.h"
+#include "_cgo_export.h"
+
+static cairo_user_data_key_t user_font_key;
+
+uintptr_t user_font_face_handle(cairo_font_face_t *font_face)
+{
+	return (uintptr_t)cairo_font_face_get_user_data(font_face, &user_font_key);
+}
+
+static uintptr_t user_font_handle(cairo_scaled_font_t *scaled_font)
+{
+	return user_font_face_handle(cairo_scaled_font_get_font_face(scaled_font));
+}
+
+static cairo_status_t user_font_init(cairo_scaled_font_t *scaled_font,
+	cairo_t *cr, cairo_font_extents_t *extents)
+{
+	return goUserFontInit(user_font_handle(scaled_font), scaled_font, cr, extents);
+}
+
+static cairo_status_t user_font_render_glyph(cairo_scaled_font_t *scaled_font,
+	unsigned long glyph, cairo_t *cr, cairo_text_extents_t *extents)
+{
+	return goUserFontRenderGlyph(user_font_handle(scaled_font), scaled_font, glyph, cr, extents);
+}
+
+static cairo_status_t user_font_unicode_to_glyph(cairo_scaled_font_t *scaled_font,
+	unsigned long unicode, unsigned long *glyph_index)
+{
+	return goUserFontUnicodeToGlyph(user_font_handle(scaled_font), scaled_font, unicode, glyph_index);
+}
+
+static cairo_status_t user_font_text_to_glyphs(cairo_scaled_font_t *scaled_font,
+	const char *utf8, int utf8_len,
+	cairo_glyph_t **glyphs, int *num_glyphs,
+	cairo_text_cluster_t **clusters, int *num_clusters,
+	cairo_text_cluster_flags_t *cluster_flags)
+{
+	return goUserFontTextToGlyphs(user_font_handle(scaled_font), scaled_font,
+		(char *)utf8, utf8_len, glyphs, num_glyphs, clusters, num_clusters, cluster_flags);
+}
+
+cairo_font_face_t *user_font_face_create(uintptr_t handle, int unicode_to_glyph, int text_to_glyphs)
+{
+	cairo_font_face_t *font_face;
+	cairo_status_t status;
+
+	font_face = cairo_user_font_face_create();
+
+	status = cairo_font_face_set_user_data(font_face, &user_font_key, (void *)handle, handle_release);
+	if (status != CAIRO_STATUS_SUCCESS) {
+		cairo_font_face_destroy(font_face);
+		return NULL;
+	}
+
+	cairo_user_font_face_set_init_func(font_face, user_font_init);
+	cairo_user_font_face_set_render_glyph_func(font_face, user_font_render_glyph);
+	if (unicode_to_glyph) {
+		cairo_user_font_face_set_unicode_to_glyph_func(font_face, user_font_unicode_to_glyph);
+	}
+	if (text_to_glyphs) {
+		cairo_user_font_face_set_text_to_glyphs_func(font_face, user_font_text_to_glyphs);
+	}
+
+	return font_face;
+}
@@ -0,0 +1,340 @@
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdint.h>
+// #include <stdlib.h>
+// #include <cairo.h>
+//
+// extern cairo_font_face_t *user_font_face_create(uintptr_t handle, int unicode_to_glyph, int text_to_glyphs);
+// extern uintptr_t user_font_face_handle(cairo_font_face_t *font_face);
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrUserFontNotImplemented can be returned by the optional UserFont methods
+// to make cairo fall back to the default behaviour.
+// It also matches the errors of the STATUS_USER_FONT_NOT_IMPLEMENTED status.
+var ErrUserFontNotImplemented error = StatusError(STATUS_USER_FONT_NOT_IMPLEMENTED)
+
+// ErrUserFontError matches the errors of the STATUS_USER_FONT_ERROR status.
+// The errors returned by ScaledFont.Err and TextToGlyphs for a user font also
+// wrap the last error returned by a UserFont method for the scaled font,
+// and the errors of FontFace.Err and NewScaledFont wrap the last Init error.
+var ErrUserFontError error = StatusError(STATUS_USER_FONT_ERROR)
+
+// UserFont is a font which glyphs are drawn by Go code.
+//
+// The ScaledFont and Canvas passed to the methods are valid only during the call.
+// An error returned by a method puts the scaled font into the STATUS_USER_FONT_ERROR state.
+// The error is kept until the next successful call and returned by the Err methods.
+type UserFont interface {
+
+	// Init is called once for each new scaled font. It may set the font extents
+	// in font space, which otherwise default to ascent 1, descent 0, height 1.
+	Init(sf *ScaledFont, c *Canvas, extents *FontExtents) error
+
+	// RenderGlyph draws the glyph to c in font space and sets the advance
+	// of the glyph in extents. The source is set to the current color.
+	RenderGlyph(sf *ScaledFont, glyph uint64, c *Canvas, extents *TextExtents) error
+}
+
+// UserFontUnicodeToGlyph is implemented by user fonts that map characters
+// to glyph indices other than the characters' code points.
+type UserFontUnicodeToGlyph interface {
+	UnicodeToGlyph(sf *ScaledFont, r rune) (uint64, error)
+}
+
+// UserFontTextToGlyphs is implemented by user fonts that do their own text shaping.
+type UserFontTextToGlyphs interface {
+	TextToGlyphs(sf *ScaledFont, text string) ([]Glyph, []TextCluster, TextClusterFlags, error)
+}
+
+func NewUserFontFace(font UserFont) (*FontFace, error) {
+
+	var unicodeToGlyph, textToGlyphs C.int
+	if _, ok := font.(UserFontUnicodeToGlyph); ok {
+		unicodeToGlyph = 1
+	}
+	if _, ok := font.(UserFontTextToGlyphs); ok {
+		textToGlyphs = 1
+	}
+
+	h := newHandle(&userFontState{
+		font: font,
+		errs: make(map[*C.cairo_scaled_font_t]error),
+	})
+
+	fontFaceNative := C.user_font_face_create(C.uintptr_t(h), unicodeToGlyph, textToGlyphs)
+	if fontFaceNative == nil {
+		releaseHandle(h)
+		return nil, checkStatus(STATUS_NO_MEMORY)
+	}
+
+	return newFontFace(fontFaceNative)
+}
+
+type userFontState struct {
+	sync.Mutex
+	font UserFont
+
+	// errs holds the error of the last failed call for each scaled font.
+	// An entry is removed by a successful call, and Init is called first
+	// for a new scaled font at the same address.
+	errs map[*C.cairo_scaled_font_t]error
+
+	// initErr is the error of the last Init call. cairo discards the scaled
+	// font of a failed Init, so it is not kept in errs.
+	initErr error
+}
+
+func (state *userFontState) setErr(scaledFontNative *C.cairo_scaled_font_t, init bool, err error) {
+
+	if errors.Is(err, ErrUserFontNotImplemented) {
+		err = nil
+	}
+
+	state.Lock()
+	defer state.Unlock()
+
+	if init {
+		state.initErr = err
+	}
+
+	if (err == nil) || init {
+		delete(state.errs, scaledFontNative)
+	} else {
+		state.errs[scaledFontNative] = err
+	}
+}
+
+type userFontError struct {
+	err error
+}
+
+func (e *userFontError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrUserFontError, e.err)
+}
+
+func (e *userFontError) Unwrap() error {
+	return e.err
+}
+
+func (e *userFontError) Is(target error) bool {
+	return target == ErrUserFontError
+}
+
+// userFontStatusError returns the error of status for the font face. For
+// STATUS_USER_FONT_ERROR it wraps the last error of the scaled font, or of
+// the last Init call if scaledFontNative is nil.
+func userFontStatusError(fontFaceNative *C.cairo_font_face_t, scaledFontNative *C.cairo_scaled_font_t, status Status) error {
+
+	if status != STATUS_USER_FONT_ERROR {
+		return checkStatus(status)
+	}
+
+	if fontFaceNative == nil {
+		return ErrUserFontError
+	}
+
+	state, ok := handleValue(uintptr(C.user_font_face_handle(fontFaceNative))).(*userFontState)
+	if !ok {
+		return ErrUserFontError
+	}
+
+	state.Lock()
+	err := state.initErr
+	if scaledFontNative != nil {
+		err = state.errs[scaledFontNative]
+	}
+	state.Unlock()
+
+	if err == nil {
+		return ErrUserFontError
+	}
+	return &userFontError{err}
+}
+
+// Err returns the error of the font face status or nil.
+func (f *FontFace) Err() error {
+	if f.isDestroyed() {
+		return ErrDestroyed
+	}
+	return userFontStatusError(f.fontFaceNative, nil, f.Status())
+}
+
+// Err returns the error of the scaled font status or nil.
+func (sf *ScaledFont) Err() error {
+	if sf.isDestroyed() {
+		return ErrDestroyed
+	}
+	return userFontStatusError(C.cairo_scaled_font_get_font_face(sf.scaledFontNative),
+		sf.scaledFontNative, sf.Status())
+}
+
+func userFontStatus(err error) C.cairo_status_t {
+	switch {
+	case err == nil:
+		return C.CAIRO_STATUS_SUCCESS
+	case errors.Is(err, ErrUserFontNotImplemented):
+		return C.CAIRO_STATUS_USER_FONT_NOT_IMPLEMENTED
+	default:
+		return C.CAIRO_STATUS_USER_FONT_ERROR
+	}
+}
+
+// userFontCall calls f with the user font of handle h and the borrowed
+// wrappers of the scaled font and the context.
+// A panic in f is turned into an error, because it must not unwind through cairo.
+func userFontCall(h C.uintptr_t, scaledFontNative *C.cairo_scaled_font_t, cr *C.cairo_t, init bool,
+	f func(font UserFont, sf *ScaledFont, c *Canvas) error) (status C.cairo_status_t) {
+
+	state, ok := handleValue(uintptr(h)).(*userFontState)
+	if !ok {
+		return C.CAIRO_STATUS_USER_FONT_ERROR
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err := fmt.Errorf("cairo: user font panic: %v", r)
+			state.setErr(scaledFontNative, init, err)
+			status = userFontStatus(err)
+		}
+	}()
+
+	sf, err := newScaledFont(C.cairo_scaled_font_reference(scaledFontNative))
+	if err != nil {
+		return C.CAIRO_STATUS_USER_FONT_ERROR
+	}
+	defer sf.Destroy()
+
+	var c *Canvas
+	if cr != nil {
+		c, err = newCanvas(C.cairo_reference(cr))
+		if err != nil {
+			return C.CAIRO_STATUS_USER_FONT_ERROR
+		}
+		defer c.Destroy()
+	}
+
+	err = f(state.font, sf, c)
+	state.setErr(scaledFontNative, init, err)
+
+	return userFontStatus(err)
+}
+
+//export goUserFontInit
+func goUserFontInit(h C.uintptr_t, scaledFontNative *C.cairo_scaled_font_t, cr *C.cairo_t,
+	extentsNative *C.cairo_font_extents_t) C.cairo_status_t {
+
+	return userFontCall(h, scaledFontNative, cr, true, func(font UserFont, sf *ScaledFont, c *Canvas) error {
+
+		var extents FontExtents
+		extents.setNative(extentsNative)
+
+		err := font.Init(sf, c, &extents)
+		if err != nil {
+			return err
+		}
+
+		extentsNative.ascent = C.double(extents.Ascent)
+		extentsNative.descent = C.double(extents.Descent)
+		extentsNative.height = C.double(extents.Height)
+		extentsNative.max_x_advance = C.double(extents.MaxAdvanceX)
+		extentsNative.max_y_advance = C.double(extents.MaxAdvanceY)
+
+		return nil
+	})
+}
+
+//export goUserFontRenderGlyph
+func goUserFontRenderGlyph(h C.uintptr_t, scaledFontNative *C.cairo_scaled_font_t, glyph C.ulong,
+	cr *C.cairo_t, extentsNative *C.cairo_text_extents_t) C.cairo_status_t {
+
+	return userFontCall(h, scaledFontNative, cr, false, func(font UserFont, sf *ScaledFont, c *Canvas) error {
+
+		var extents TextExtents
+		extents.setNative(extentsNative)
+
+		err := font.RenderGlyph(sf, uint64(glyph), c, &extents)
+		if err != nil {
+			return err
+		}
+
+		extentsNative.x_advance = C.double(extents.AdvanceX)
+		extentsNative.y_advance = C.double(extents.AdvanceY)
+
+		return nil
+	})
+}
+
+//export goUserFontUnicodeToGlyph
+func goUserFontUnicodeToGlyph(h C.uintptr_t, scaledFontNative *C.cairo_scaled_font_t, unicode C.ulong,
+	glyphIndex *C.ulong) C.cairo_status_t {
+
+	return userFontCall(h, scaledFontNative, nil, false, func(font UserFont, sf *ScaledFont, c *Canvas) error {
+
+		u, ok := font.(UserFontUnicodeToGlyph)
+		if !ok {
+			return ErrUserFontNotImplemented
+		}
+
+		index, err := u.UnicodeToGlyph(sf, rune(unicode))
+		if err != nil {
+			return err
+		}
+
+		*glyphIndex = C.ulong(index)
+
+		return nil
+	})
+}
+
+//export goUserFontTextToGlyphs
+func goUserFontTextToGlyphs(h C.uintptr_t, scaledFontNative *C.cairo_scaled_font_t,
+	utf8Native *C.char, utf8Len C.int,
+	glyphsNative **C.cairo_glyph_t, numGlyphs *C.int,
+	clustersNative **C.cairo_text_cluster_t, numClusters *C.int,
+	clusterFlags *C.cairo_text_cluster_flags_t) C.cairo_status_t {
+
+	return userFontCall(h, scaledFontNative, nil, false, func(font UserFont, sf *ScaledFont, c *Canvas) error {
+
+		t, ok := font.(UserFontTextToGlyphs)
+		if !ok {
+			return ErrUserFontNotImplemented
+		}
+
+		text := C.GoStringN(utf8Native, utf8Len)
+		if !utf8.ValidString(text) {
+			return checkStatus(STATUS_INVALID_STRING)
+		}
+
+		glyphs, clusters, flags, err := t.TextToGlyphs(sf, text)
+		if err != nil {
+			return err
+		}
+
+		// The arrays are allocated by cairo functions, so cairo frees them.
+		*glyphsNative = glyphsToAllocated(glyphs)
+		if (*glyphsNative == nil) && (len(glyphs) > 0) {
+			return checkStatus(STATUS_NO_MEMORY)
+		}
+		*numGlyphs = C.int(len(glyphs))
+
+		// Clusters are not requested by the caller if clustersNative is nil.
+		if clustersNative != nil {
+			*clustersNative = clustersToAllocated(clusters)
+			if (*clustersNative == nil) && (len(clusters) > 0) {
+				return checkStatus(STATUS_NO_MEMORY)
+			}
+			*numClusters = C.int(len(clusters))
+			*clusterFlags = C.cairo_text_cluster_flags_t(flags)
+		}
+
+		return nil
+	})
+}